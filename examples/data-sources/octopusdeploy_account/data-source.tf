@@ -0,0 +1,4 @@
+data "octopusdeploy_account" "example" {
+  name     = "AWS Account"
+  space_id = "Spaces-1"
+}
@@ -0,0 +1,7 @@
+data "octopusdeploy_environment" "example" {
+  name = "Production"
+}
+
+data "octopusdeploy_environment" "by_id" {
+  id = "Environments-123"
+}
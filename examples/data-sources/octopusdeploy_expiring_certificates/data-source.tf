@@ -0,0 +1,4 @@
+data "octopusdeploy_expiring_certificates" "example" {
+  include_expired = false
+  within          = "720h"
+}
@@ -0,0 +1,3 @@
+data "octopusdeploy_feed" "example" {
+  name = "Octopus Server (built-in)"
+}
@@ -0,0 +1,6 @@
+data "octopusdeploy_insights_metrics" "example" {
+  end_time    = "2023-03-31T23:59:59Z"
+  granularity = "Weekly"
+  report_id   = "InsightsReports-123"
+  start_time  = "2023-01-01T00:00:00Z"
+}
@@ -0,0 +1,3 @@
+data "octopusdeploy_lifecycle" "example" {
+  name = "Default Lifecycle"
+}
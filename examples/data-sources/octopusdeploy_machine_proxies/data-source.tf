@@ -0,0 +1,6 @@
+data "octopusdeploy_machine_proxies" "example" {
+  ids          = ["Proxies-123", "Proxies-321"]
+  partial_name = "DMZ"
+  skip         = 5
+  take         = 100
+}
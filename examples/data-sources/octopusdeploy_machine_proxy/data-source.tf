@@ -0,0 +1,7 @@
+data "octopusdeploy_machine_proxy" "example" {
+  name = "DMZ Proxy"
+}
+
+data "octopusdeploy_machine_proxy" "by_id" {
+  id = "Proxies-123"
+}
@@ -0,0 +1,4 @@
+data "octopusdeploy_project" "example" {
+  name     = "Web Application"
+  space_id = "Spaces-1"
+}
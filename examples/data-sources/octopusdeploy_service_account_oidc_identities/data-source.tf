@@ -0,0 +1,7 @@
+data "octopusdeploy_service_account_oidc_identities" "example" {
+  service_account_id = "Users-123"
+}
+
+output "octopus_audience" {
+  value = data.octopusdeploy_service_account_oidc_identities.example.external_id
+}
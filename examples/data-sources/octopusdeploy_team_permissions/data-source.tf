@@ -0,0 +1,4 @@
+data "octopusdeploy_team_permissions" "example" {
+  permissions = ["DeploymentCreate", "ProjectEdit"]
+  team_id     = "Teams-123"
+}
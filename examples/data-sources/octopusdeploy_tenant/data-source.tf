@@ -0,0 +1,3 @@
+data "octopusdeploy_tenant" "example" {
+  name = "Acme Corporation"
+}
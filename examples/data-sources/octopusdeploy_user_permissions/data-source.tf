@@ -0,0 +1,13 @@
+data "octopusdeploy_user_permissions" "example" {
+  permissions = ["DeploymentCreate"]
+  space_id    = "Spaces-1"
+  user_id     = "Users-123"
+}
+
+# the scoped user roles that allow the user to deploy to production
+output "production_deployments" {
+  value = [
+    for permission in data.octopusdeploy_user_permissions.example.space_permissions : permission
+    if length(permission.environment_ids) == 0 || contains(permission.environment_ids, "Environments-123")
+  ]
+}
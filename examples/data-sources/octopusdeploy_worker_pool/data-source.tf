@@ -0,0 +1,3 @@
+data "octopusdeploy_worker_pool" "example" {
+  name = "Default Worker Pool"
+}
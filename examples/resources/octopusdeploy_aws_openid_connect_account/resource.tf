@@ -0,0 +1,8 @@
+resource "octopusdeploy_aws_openid_connect_account" "example" {
+  name                      = "AWS OIDC Account (OK to Delete)"
+  role_arn                  = "arn:aws:iam::123456789012:role/octopus-deploy"
+  session_duration          = 3600
+  execution_subject_keys    = ["space", "project", "environment"]
+  health_subject_keys       = ["space", "target"]
+  account_test_subject_keys = ["space", "account"]
+}
@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_azure_ad_authentication.<name> authentication-aad
\ No newline at end of file
@@ -0,0 +1,7 @@
+resource "octopusdeploy_azure_ad_authentication" "example" {
+  allow_auto_user_creation = true
+  client_id                = "00000000-0000-0000-0000-000000000000"
+  is_enabled               = true
+  issuer                   = "https://login.microsoftonline.com/00000000-0000-0000-0000-000000000000"
+  role_claim_type          = "roles"
+}
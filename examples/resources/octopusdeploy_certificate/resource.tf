@@ -3,3 +3,29 @@ resource "octopusdeploy_certificate" "example" {
   name             = "Development Certificate"
   password         = "###########" # required; get from secure environment/store
 }
+
+resource "octopusdeploy_certificate" "rotated" {
+  certificate_data   = filebase64("certificates/web.pfx")
+  name               = "Web Certificate"
+  password           = "###########" # required; get from secure environment/store
+  replace_in_place   = true
+  warn_before_expiry = "720h"
+}
+
+resource "octopusdeploy_certificate" "pem" {
+  certificate_chain_pem = file("certificates/intermediate.pem")
+  certificate_pem       = file("certificates/web.pem")
+  name                  = "Web Certificate (PEM)"
+  private_key_pem       = file("certificates/web.key") # get from secure environment/store
+}
+
+resource "octopusdeploy_certificate" "self_signed" {
+  name = "Development Certificate (Self-Signed)"
+
+  generate {
+    common_name   = "dev.example.com"
+    key_algorithm = "ECDSA_P256"
+    sans          = ["dev.example.com", "10.0.0.10"]
+    validity_days = 90
+  }
+}
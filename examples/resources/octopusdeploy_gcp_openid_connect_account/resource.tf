@@ -0,0 +1,5 @@
+resource "octopusdeploy_gcp_openid_connect_account" "example" {
+  audience               = "//iam.googleapis.com/projects/123456789012/locations/global/workloadIdentityPools/octopus/providers/octopus"
+  name                   = "GCP OIDC Account (OK to Delete)"
+  execution_subject_keys = ["space", "project"]
+}
@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_google_workspace_authentication.<name> authentication-googleapps
\ No newline at end of file
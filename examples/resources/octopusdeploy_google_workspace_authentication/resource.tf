@@ -0,0 +1,6 @@
+resource "octopusdeploy_google_workspace_authentication" "example" {
+  allow_auto_user_creation = false
+  client_id                = "000000000000-example.apps.googleusercontent.com"
+  hosted_domain            = "example.com"
+  is_enabled               = true
+}
@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_insights_report.<name> <insights_report-id>
\ No newline at end of file
@@ -0,0 +1,10 @@
+resource "octopusdeploy_insights_report" "example" {
+  cadence           = "LastQuarter"
+  description       = "DORA metrics for the web platform."
+  environment_ids   = ["Environments-123"]
+  name              = "Web Platform (OK to Delete)"
+  project_group_ids = ["ProjectGroups-123"]
+  project_ids       = ["Projects-123", "Projects-321"]
+  tenant_tags       = ["Regions/us-east"]
+  time_zone         = "Australia/Brisbane"
+}
@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_ldap_authentication.<name> authentication-ldap
\ No newline at end of file
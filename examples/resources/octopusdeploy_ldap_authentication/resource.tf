@@ -0,0 +1,9 @@
+resource "octopusdeploy_ldap_authentication" "example" {
+  base_dn           = "DC=example,DC=com"
+  connect_password  = "###########" # required
+  connect_username  = "CN=octopus,OU=Service Accounts,DC=example,DC=com"
+  is_enabled        = true
+  port              = 636
+  security_protocol = "SSL"
+  server            = "ldap.example.com"
+}
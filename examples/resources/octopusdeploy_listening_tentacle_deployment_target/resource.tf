@@ -8,3 +8,36 @@ resource "octopusdeploy_listening_tentacle_deployment_target" "example" {
   tentacle_url                      = "https://example.com:1234/"
   thumbprint                        = "<thumbprint>"
 }
+
+resource "octopusdeploy_listening_tentacle_deployment_target" "healthy" {
+  environments = ["Environments-123"]
+  name         = "Listening Tentacle Deployment Target (Wait for Health)"
+  roles        = ["Web Server"]
+  tentacle_url = "https://example.com:10933/"
+  thumbprint   = "<thumbprint>"
+
+  wait_for_health {
+    required_status = ["Healthy", "HasWarnings"]
+    timeout         = "15m"
+  }
+}
+
+
+resource "octopusdeploy_listening_tentacle_deployment_target" "tenanted" {
+  environments                      = ["Environments-123"]
+  name                              = "Listening Tentacle Deployment Target (Tenanted)"
+  roles                             = ["Web Server"]
+  tenanted_deployment_participation = "Tenanted"
+  tentacle_url                      = "https://example.com:10933/"
+  thumbprint                        = "<thumbprint>"
+
+  tenant_tag {
+    tag_set = "Region"
+    tag     = "EU"
+  }
+
+  tenant_tag {
+    tag_set = "Tier"
+    tag     = "Gold"
+  }
+}
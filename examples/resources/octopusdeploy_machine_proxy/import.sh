@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_machine_proxy.<name> <machine-proxy-id>
\ No newline at end of file
@@ -0,0 +1,16 @@
+resource "octopusdeploy_machine_proxy" "dmz" {
+  host     = "proxy.dmz.example.com"
+  name     = "DMZ Proxy"
+  password = "###########" # get from secure environment/store
+  port     = 3128
+  username = "octopus"
+}
+
+resource "octopusdeploy_listening_tentacle_deployment_target" "dmz" {
+  environments = ["Environments-123"]
+  name         = "DMZ Web Server"
+  proxy_id     = octopusdeploy_machine_proxy.dmz.id
+  roles        = ["Web Server"]
+  tentacle_url = "https://web.dmz.example.com:10933/"
+  thumbprint   = "<thumbprint>"
+}
@@ -0,0 +1,7 @@
+resource "octopusdeploy_okta_authentication" "example" {
+  client_id       = "0oa0000000000000000"
+  client_secret   = "###########" # required
+  is_enabled      = true
+  issuer          = "https://example.okta.com"
+  role_claim_type = "groups"
+}
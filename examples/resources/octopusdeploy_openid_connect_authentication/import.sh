@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_openid_connect_authentication.<name> authentication-oidc
\ No newline at end of file
@@ -0,0 +1,8 @@
+resource "octopusdeploy_openid_connect_authentication" "example" {
+  client_id       = "octopus"
+  client_secret   = "###########" # required
+  is_enabled      = true
+  issuer          = "https://keycloak.example.com/realms/example"
+  role_claim_type = "groups"
+  scope           = "openid profile email"
+}
@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_process_step.<name> <process-id>:<step-id-or-name>
\ No newline at end of file
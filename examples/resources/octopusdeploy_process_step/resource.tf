@@ -0,0 +1,12 @@
+# append a smoke test step to the deployment process of an existing project
+resource "octopusdeploy_process_step" "smoke_test" {
+  process_id = "deploymentprocess-Projects-123"
+  name       = "Smoke test"
+  run_script_action {
+    name          = "Smoke test"
+    run_on_server = true
+    script_body   = <<-EOT
+        Write-Host 'Running smoke tests'
+      EOT
+  }
+}
@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_process_step_order.<name> <process-id>
\ No newline at end of file
@@ -0,0 +1,7 @@
+resource "octopusdeploy_process_step_order" "example" {
+  process_id = "deploymentprocess-Projects-123"
+  steps = [
+    octopusdeploy_process_step.deploy.step_id,
+    octopusdeploy_process_step.smoke_test.step_id,
+  ]
+}
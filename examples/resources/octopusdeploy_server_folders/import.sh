@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_server_folders.<name> server-folders
\ No newline at end of file
@@ -0,0 +1,5 @@
+resource "octopusdeploy_server_folders" "example" {
+  artifacts_directory     = "\\\\fileserver\\octopus\\Artifacts"
+  event_exports_directory = "\\\\fileserver\\octopus\\EventExports"
+  task_logs_directory     = "\\\\fileserver\\octopus\\TaskLogs"
+}
@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_server_task_cap.<name> <node-id>
\ No newline at end of file
@@ -0,0 +1,4 @@
+resource "octopusdeploy_server_task_cap" "example" {
+  max_concurrent_tasks = 20
+  node_id              = "OctopusServerNodes-octopus-01"
+}
@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_service_account_oidc_identity.<name> <service_account-id>:<oidc_identity-id>
\ No newline at end of file
@@ -0,0 +1,13 @@
+resource "octopusdeploy_user" "github_actions" {
+  display_name = "GitHub Actions"
+  is_active    = true
+  is_service   = true
+  username     = "github-actions"
+}
+
+resource "octopusdeploy_service_account_oidc_identity" "example" {
+  issuer             = "https://token.actions.githubusercontent.com"
+  name               = "Production deployments"
+  service_account_id = octopusdeploy_user.github_actions.id
+  subject            = "repo:octopusdeploy/example:environment:production"
+}
@@ -0,0 +1,8 @@
+resource "octopusdeploy_smtp_configuration" "example" {
+  enable_ssl      = true
+  host            = "smtp.example.com"
+  login           = "octopus"
+  password        = var.smtp_password
+  port            = 587
+  send_email_from = "octopus@example.com"
+}
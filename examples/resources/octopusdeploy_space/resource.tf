@@ -5,4 +5,15 @@ resource "octopusdeploy_space" "example" {
   is_task_queue_stopped       = false
   space_managers_team_members = ["Users-123", "Users-321"]
   space_managers_teams        = ["teams-everyone"]
-}
\ No newline at end of file
+}
+
+resource "octopusdeploy_space" "production" {
+  drain_before_destroy         = true
+  name                         = "Production"
+  prevent_destroy_if_not_empty = true
+  space_managers_teams         = ["teams-managers"]
+
+  timeouts {
+    delete = "1h"
+  }
+}
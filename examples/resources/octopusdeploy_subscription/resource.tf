@@ -0,0 +1,26 @@
+resource "octopusdeploy_subscription" "audit" {
+  event_groups = ["User", "Team", "UserRole"]
+  name         = "Audit events to SIEM"
+
+  webhook {
+    timeout = 30
+    url     = "https://siem.example.com/octopus"
+
+    header {
+      key   = "Authorization"
+      value = var.siem_token
+    }
+  }
+}
+
+resource "octopusdeploy_subscription" "failed_production_deployments" {
+  environment_ids  = ["Environments-123"]
+  event_categories = ["DeploymentFailed"]
+  name             = "Failed production deployments"
+
+  email_digest {
+    frequency = "15m"
+    priority  = "High"
+    team_ids  = ["Teams-123"]
+  }
+}
@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_team_external_group.<name> <team-id>:<group-id>
\ No newline at end of file
@@ -0,0 +1,5 @@
+resource "octopusdeploy_team_external_group" "example" {
+  display_name = "Deployment Engineers"
+  group_id     = "S-1-5-21-1004336348-1177238915-682003330-512"
+  team_id      = "Teams-123"
+}
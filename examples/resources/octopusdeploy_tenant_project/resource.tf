@@ -0,0 +1,10 @@
+resource "octopusdeploy_tenant" "example" {
+  ignore_unmanaged_project_environments = true
+  name                                  = "Tenant (OK to Delete)"
+}
+
+resource "octopusdeploy_tenant_project" "example" {
+  environment_ids = ["Environments-123", "Environments-321"]
+  project_id      = "Projects-123"
+  tenant_id       = octopusdeploy_tenant.example.id
+}
@@ -0,0 +1,10 @@
+resource "octopusdeploy_tentacle_upgrade" "production_web_servers" {
+  environments    = ["Environments-123"]
+  roles           = ["Web Server"]
+  timeout         = "1h"
+  update_calamari = true
+}
+
+resource "octopusdeploy_tentacle_upgrade" "example" {
+  machine_ids = ["Machines-123", "Machines-321"]
+}
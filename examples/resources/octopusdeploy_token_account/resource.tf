@@ -2,3 +2,11 @@ resource "octopusdeploy_token_account" "example" {
   name  = "Token Account (OK to Delete)"
   token = "[token]"
 }
+
+# change credential_version to re-send the token to Octopus Deploy (e.g. after
+# it was rotated or changed outside of Terraform)
+resource "octopusdeploy_token_account" "rotated" {
+  credential_version = "2"
+  name               = "Rotated Token Account (OK to Delete)"
+  token              = "[token]"
+}
@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_user_api_key.<name> <user-id>:<api_key-id>
\ No newline at end of file
@@ -0,0 +1,13 @@
+resource "octopusdeploy_user" "deployer" {
+  display_name = "Deployment Automation"
+  is_active    = true
+  is_service   = true
+  username     = "deployment-automation"
+}
+
+resource "octopusdeploy_user_api_key" "example" {
+  expires_in_days      = 90
+  purpose              = "Deployments from CI"
+  rotation_window_days = 30
+  user_id              = octopusdeploy_user.deployer.id
+}
@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_username_password_authentication.<name> authentication-od
\ No newline at end of file
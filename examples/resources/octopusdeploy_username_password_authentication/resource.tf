@@ -0,0 +1,3 @@
+resource "octopusdeploy_username_password_authentication" "example" {
+  is_enabled = false
+}
@@ -0,0 +1 @@
+terraform import [options] octopusdeploy_variable_set.<name> <owner-id>
\ No newline at end of file
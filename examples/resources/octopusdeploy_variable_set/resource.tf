@@ -0,0 +1,26 @@
+resource "octopusdeploy_variable_set" "example" {
+  owner_id = "Projects-123"
+
+  variable {
+    name  = "Greeting"
+    type  = "String"
+    value = "Hello, world!"
+  }
+
+  variable {
+    name  = "Greeting"
+    type  = "String"
+    value = "Hello, production!"
+
+    scope {
+      environments = ["Environments-123"]
+    }
+  }
+
+  variable {
+    is_sensitive    = true
+    name            = "Database.Password"
+    sensitive_value = "###########" # required
+    type            = "Sensitive"
+  }
+}
@@ -0,0 +1,27 @@
+package authentication
+
+import (
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/serverconfiguration"
+)
+
+// Configuration IDs of the server configuration sections of the authentication providers.
+const (
+	AzureADConfigurationID          = "authentication-aad"
+	GoogleAppsConfigurationID       = "authentication-googleapps"
+	LDAPConfigurationID             = "authentication-ldap"
+	OktaConfigurationID             = "authentication-okta"
+	OpenIDConnectConfigurationID    = "authentication-oidc"
+	UsernamePasswordConfigurationID = "authentication-od"
+)
+
+// GetConfiguration returns the values of the server configuration section that matches the input ID.
+func GetConfiguration[TConfiguration any](client newclient.Client, configurationID string) (*TConfiguration, error) {
+	return serverconfiguration.GetConfiguration[TConfiguration](client, configurationID)
+}
+
+// UpdateConfiguration modifies the values of the server configuration section that matches the input ID. Values
+// that are not present in the input are left unchanged.
+func UpdateConfiguration(client newclient.Client, configurationID string, configuration any) error {
+	return serverconfiguration.UpdateConfiguration(client, configurationID, configuration)
+}
@@ -0,0 +1,65 @@
+package authentication
+
+import "github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+
+// OpenIDConnectSettings are the settings shared by the authentication providers that are based on OpenID Connect.
+type OpenIDConnectSettings struct {
+	AllowAutoUserCreation bool                 `json:"AllowAutoUserCreation"`
+	ClientID              string               `json:"ClientId"`
+	ClientSecret          *core.SensitiveValue `json:"ClientSecret,omitempty"`
+	IsEnabled             bool                 `json:"IsEnabled"`
+	Issuer                string               `json:"Issuer"`
+	RoleClaimType         string               `json:"RoleClaimType,omitempty"`
+}
+
+// AzureADConfiguration is the configuration of the Azure Active Directory (Microsoft Entra ID) authentication provider.
+type AzureADConfiguration struct {
+	OpenIDConnectSettings
+}
+
+// GoogleAppsConfiguration is the configuration of the Google Workspace authentication provider.
+type GoogleAppsConfiguration struct {
+	AllowAutoUserCreation bool                 `json:"AllowAutoUserCreation"`
+	ClientID              string               `json:"ClientId"`
+	ClientSecret          *core.SensitiveValue `json:"ClientSecret,omitempty"`
+	HostedDomain          string               `json:"HostedDomain"`
+	IsEnabled             bool                 `json:"IsEnabled"`
+}
+
+// LDAPConfiguration is the configuration of the LDAP authentication provider.
+type LDAPConfiguration struct {
+	AllowAutoUserCreation      bool                 `json:"AllowAutoUserCreation"`
+	BaseDN                     string               `json:"BaseDn"`
+	ConnectPassword            *core.SensitiveValue `json:"ConnectPassword,omitempty"`
+	ConnectUsername            string               `json:"ConnectUsername"`
+	DefaultDomain              string               `json:"DefaultDomain,omitempty"`
+	GroupFilter                string               `json:"GroupFilter,omitempty"`
+	IgnoreSSLErrors            bool                 `json:"IgnoreSslErrors"`
+	IsEnabled                  bool                 `json:"IsEnabled"`
+	NestedGroupFilter          string               `json:"NestedGroupFilter,omitempty"`
+	NestedGroupSearchDepth     int                  `json:"NestedGroupSearchDepth"`
+	Port                       int                  `json:"Port"`
+	SecurityProtocol           string               `json:"SecurityProtocol"`
+	Server                     string               `json:"Server"`
+	UniqueAccountNameAttribute string               `json:"UniqueAccountNameAttribute,omitempty"`
+	UserFilter                 string               `json:"UserFilter,omitempty"`
+}
+
+// OktaConfiguration is the configuration of the Okta authentication provider.
+type OktaConfiguration struct {
+	OpenIDConnectSettings
+	UsernameClaimType string `json:"UsernameClaimType,omitempty"`
+}
+
+// OpenIDConnectConfiguration is the configuration of the generic OpenID Connect authentication provider.
+type OpenIDConnectConfiguration struct {
+	OpenIDConnectSettings
+	NameClaimType     string `json:"NameClaimType,omitempty"`
+	Scope             string `json:"Scope,omitempty"`
+	UsernameClaimType string `json:"UsernameClaimType,omitempty"`
+}
+
+// UsernamePasswordConfiguration is the configuration of the username/password authentication provider.
+type UsernamePasswordConfiguration struct {
+	IsEnabled bool `json:"IsEnabled"`
+}
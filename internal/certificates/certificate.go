@@ -0,0 +1,32 @@
+package certificates
+
+import (
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/certificates"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+)
+
+const certificateReplaceTemplate = "/api/{spaceId}/certificates/{id}/replace"
+
+// Replace replaces the data of a certificate while keeping its ID. The server archives the previous data as a new
+// certificate whose ReplacedBy is the input ID. The replace endpoint returns that archived certificate, so the current
+// one is read again before it is returned. Unlike the Replace method of the client, the certificate is replaced in the
+// given space rather than the default space of the client.
+func Replace(client newclient.Client, spaceID string, id string, replacementCertificate *certificates.ReplacementCertificate) (*certificates.CertificateResource, error) {
+	if len(spaceID) == 0 {
+		spaceID = client.GetSpaceID()
+	}
+
+	path, err := client.URITemplateCache().Expand(certificateReplaceTemplate, map[string]any{
+		"id":      id,
+		"spaceId": spaceID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := newclient.Post[certificates.CertificateResource](client.HttpSession(), path, replacementCertificate); err != nil {
+		return nil, err
+	}
+
+	return certificates.GetByID(client, spaceID, id)
+}
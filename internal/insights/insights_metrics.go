@@ -0,0 +1,69 @@
+package insights
+
+import (
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/uritemplates"
+)
+
+const metricsTemplate = "/api/{spaceId}/insights/reports/{id}/metrics{?startTime,endTime,granularity}"
+
+// Granularity values determine the width of each interval of a metric series.
+const (
+	GranularityDaily     = "Daily"
+	GranularityWeekly    = "Weekly"
+	GranularityMonthly   = "Monthly"
+	GranularityQuarterly = "Quarterly"
+)
+
+// Granularities is the set of interval widths supported by insights metrics.
+var Granularities = []string{
+	GranularityDaily,
+	GranularityWeekly,
+	GranularityMonthly,
+	GranularityQuarterly,
+}
+
+// MetricsQuery selects the time window and interval width of the metrics of an insights report.
+type MetricsQuery struct {
+	EndTime     string `uri:"endTime,omitempty" url:"endTime,omitempty"`
+	Granularity string `uri:"granularity,omitempty" url:"granularity,omitempty"`
+	StartTime   string `uri:"startTime,omitempty" url:"startTime,omitempty"`
+}
+
+// MetricDataPoint is the value of a metric over a single interval.
+type MetricDataPoint struct {
+	EndTime   *time.Time `json:"EndTime,omitempty"`
+	StartTime *time.Time `json:"StartTime,omitempty"`
+	Value     float64    `json:"Value"`
+}
+
+// InsightsMetrics contains the DORA metric series calculated for an insights report.
+type InsightsMetrics struct {
+	ChangeFailureRate   []*MetricDataPoint `json:"ChangeFailureRate"`
+	DeploymentFrequency []*MetricDataPoint `json:"DeploymentFrequency"`
+	LeadTime            []*MetricDataPoint `json:"LeadTime"`
+	MeanTimeToRecovery  []*MetricDataPoint `json:"MeanTimeToRecovery"`
+}
+
+// GetMetrics returns the metric series of the insights report that matches the input ID.
+func GetMetrics(client newclient.Client, spaceID string, reportID string, query MetricsQuery) (*InsightsMetrics, error) {
+	if len(spaceID) == 0 {
+		spaceID = client.GetSpaceID()
+	}
+
+	values, _ := uritemplates.Struct2map(query)
+	if values == nil {
+		values = map[string]any{}
+	}
+	values["spaceId"] = spaceID
+	values["id"] = reportID
+
+	path, err := client.URITemplateCache().Expand(metricsTemplate, values)
+	if err != nil {
+		return nil, err
+	}
+
+	return newclient.Get[InsightsMetrics](client.HttpSession(), path)
+}
@@ -0,0 +1,74 @@
+package insights
+
+import (
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+)
+
+const reportsTemplate = "/api/{spaceId}/insights/reports{/id}{?skip,take,ids,partialName}"
+
+// Cadence values determine how often the metrics of an insights report are recalculated.
+const (
+	CadenceLastMonth      = "LastMonth"
+	CadenceLastQuarter    = "LastQuarter"
+	CadenceLastYear       = "LastYear"
+	CadenceLastThreeYears = "LastThreeYears"
+)
+
+// Cadences is the set of cadences supported by insights reports.
+var Cadences = []string{
+	CadenceLastMonth,
+	CadenceLastQuarter,
+	CadenceLastYear,
+	CadenceLastThreeYears,
+}
+
+// InsightsReport defines the projects, environments and tenants for which Octopus Insights calculates DORA metrics.
+type InsightsReport struct {
+	Cadence         string   `json:"Cadence,omitempty"`
+	Description     string   `json:"Description,omitempty"`
+	EnvironmentIDs  []string `json:"EnvironmentIds"`
+	Name            string   `json:"Name"`
+	ProjectGroupIDs []string `json:"ProjectGroupIds"`
+	ProjectIDs      []string `json:"ProjectIds"`
+	SpaceID         string   `json:"SpaceId,omitempty"`
+	TenantIDs       []string `json:"TenantIds"`
+	TenantTags      []string `json:"TenantTags"`
+	TimeZone        string   `json:"TimeZone,omitempty"`
+
+	resources.Resource
+}
+
+// NewInsightsReport creates and initializes an insights report.
+func NewInsightsReport(name string) *InsightsReport {
+	return &InsightsReport{
+		Cadence:         CadenceLastQuarter,
+		EnvironmentIDs:  []string{},
+		Name:            name,
+		ProjectGroupIDs: []string{},
+		ProjectIDs:      []string{},
+		TenantIDs:       []string{},
+		TenantTags:      []string{},
+		Resource:        *resources.NewResource(),
+	}
+}
+
+// Add creates a new insights report.
+func Add(client newclient.Client, report *InsightsReport) (*InsightsReport, error) {
+	return newclient.Add[InsightsReport](client, reportsTemplate, report.SpaceID, report)
+}
+
+// DeleteByID deletes the insights report that matches the input ID.
+func DeleteByID(client newclient.Client, spaceID string, id string) error {
+	return newclient.DeleteByID(client, reportsTemplate, spaceID, id)
+}
+
+// GetByID returns the insights report that matches the input ID.
+func GetByID(client newclient.Client, spaceID string, id string) (*InsightsReport, error) {
+	return newclient.GetByID[InsightsReport](client, reportsTemplate, spaceID, id)
+}
+
+// Update modifies an insights report based on the one provided as input.
+func Update(client newclient.Client, report *InsightsReport) (*InsightsReport, error) {
+	return newclient.Update[InsightsReport](client, reportsTemplate, report.SpaceID, report.GetID(), report)
+}
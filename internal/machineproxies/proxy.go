@@ -0,0 +1,36 @@
+package machineproxies
+
+import (
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/proxies"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+)
+
+// The proxy service of go-octopusdeploy is read-only, so machine proxies are created and modified through the generic
+// client instead.
+const proxiesTemplate = "/api/{spaceId}/proxies{/id}{?skip,take,ids,partialName}"
+
+// Add creates a new machine proxy.
+func Add(client newclient.Client, proxy *proxies.Proxy) (*proxies.Proxy, error) {
+	return newclient.Add[proxies.Proxy](client, proxiesTemplate, proxy.SpaceID, proxy)
+}
+
+// DeleteByID deletes the machine proxy that matches the input ID.
+func DeleteByID(client newclient.Client, spaceID string, id string) error {
+	return newclient.DeleteByID(client, proxiesTemplate, spaceID, id)
+}
+
+// Get returns a collection of machine proxies based on the criteria defined by its input query parameter.
+func Get(client newclient.Client, spaceID string, query *proxies.ProxiesQuery) (*resources.Resources[*proxies.Proxy], error) {
+	return newclient.GetByQuery[proxies.Proxy](client, proxiesTemplate, spaceID, query)
+}
+
+// GetByID returns the machine proxy that matches the input ID.
+func GetByID(client newclient.Client, spaceID string, id string) (*proxies.Proxy, error) {
+	return newclient.GetByID[proxies.Proxy](client, proxiesTemplate, spaceID, id)
+}
+
+// Update modifies a machine proxy based on the one provided as input.
+func Update(client newclient.Client, proxy *proxies.Proxy) (*proxies.Proxy, error) {
+	return newclient.Update[proxies.Proxy](client, proxiesTemplate, proxy.SpaceID, proxy.GetID(), proxy)
+}
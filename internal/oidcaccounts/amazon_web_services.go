@@ -0,0 +1,22 @@
+package oidcaccounts
+
+// DefaultSessionDuration is the default duration, in seconds, of the sessions of AWS OIDC accounts.
+const DefaultSessionDuration = 3600
+
+// AmazonWebServicesOIDCAccount is an AWS account that assumes an IAM role with a web identity token issued by Octopus
+// Deploy, instead of using static access keys.
+type AmazonWebServicesOIDCAccount struct {
+	RoleArn         string `json:"RoleArn"`
+	SessionDuration int    `json:"SessionDuration,omitempty"`
+
+	Account
+}
+
+// NewAmazonWebServicesOIDCAccount creates and initializes an AWS OIDC account.
+func NewAmazonWebServicesOIDCAccount(name string, roleArn string) *AmazonWebServicesOIDCAccount {
+	return &AmazonWebServicesOIDCAccount{
+		Account:         newAccount(name, AccountTypeAmazonWebServicesOIDC),
+		RoleArn:         roleArn,
+		SessionDuration: DefaultSessionDuration,
+	}
+}
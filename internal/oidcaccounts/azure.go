@@ -0,0 +1,29 @@
+package oidcaccounts
+
+// DefaultAzureAudience is the default audience of the OIDC tokens that are exchanged with Microsoft Entra ID.
+const DefaultAzureAudience = "api://AzureADTokenExchange"
+
+// AzureOIDCAccount is an Azure account that authenticates as an app registration with a federated credential, instead
+// of using a client secret.
+type AzureOIDCAccount struct {
+	ApplicationID           string `json:"ClientId"`
+	Audience                string `json:"Audience,omitempty"`
+	AuthenticationEndpoint  string `json:"ActiveDirectoryEndpointBaseUri,omitempty"`
+	AzureEnvironment        string `json:"AzureEnvironment,omitempty"`
+	ResourceManagerEndpoint string `json:"ResourceManagementEndpointBaseUri,omitempty"`
+	SubscriptionID          string `json:"SubscriptionNumber"`
+	TenantID                string `json:"TenantId"`
+
+	Account
+}
+
+// NewAzureOIDCAccount creates and initializes an Azure OIDC account.
+func NewAzureOIDCAccount(name string, subscriptionID string, tenantID string, applicationID string) *AzureOIDCAccount {
+	return &AzureOIDCAccount{
+		Account:        newAccount(name, AccountTypeAzureOIDC),
+		ApplicationID:  applicationID,
+		Audience:       DefaultAzureAudience,
+		SubscriptionID: subscriptionID,
+		TenantID:       tenantID,
+	}
+}
@@ -0,0 +1,17 @@
+package oidcaccounts
+
+// GoogleCloudOIDCAccount is a GCP account that authenticates through workload identity federation, instead of using
+// a service account key. The audience identifies the workload identity pool provider.
+type GoogleCloudOIDCAccount struct {
+	Audience string `json:"Audience"`
+
+	Account
+}
+
+// NewGoogleCloudOIDCAccount creates and initializes a GCP OIDC (workload identity federation) account.
+func NewGoogleCloudOIDCAccount(name string, audience string) *GoogleCloudOIDCAccount {
+	return &GoogleCloudOIDCAccount{
+		Account:  newAccount(name, AccountTypeGoogleCloudOIDC),
+		Audience: audience,
+	}
+}
@@ -0,0 +1,59 @@
+package serverconfiguration
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+)
+
+func getConfigurationValuesPath(configurationID string) string {
+	return fmt.Sprintf("/api/configuration/%s/values", url.PathEscape(configurationID))
+}
+
+// GetConfiguration returns the values of the server configuration section that matches the input ID.
+func GetConfiguration[TConfiguration any](client newclient.Client, configurationID string) (*TConfiguration, error) {
+	return newclient.Get[TConfiguration](client.HttpSession(), getConfigurationValuesPath(configurationID))
+}
+
+// UpdateConfiguration modifies the values of the server configuration section that matches the input ID. Values
+// that are not present in the input are left unchanged.
+func UpdateConfiguration(client newclient.Client, configurationID string, configuration any) error {
+	return update(client, getConfigurationValuesPath(configurationID), configuration)
+}
+
+// update performs a read-modify-write of the resource at the input path, so that the values of the resource that
+// are not present in the input are left unchanged.
+func update(client newclient.Client, path string, configuration any) error {
+	values, err := newclient.Get[map[string]any](client.HttpSession(), path)
+	if err != nil {
+		return err
+	}
+
+	if err := MergeValues(*values, configuration); err != nil {
+		return err
+	}
+
+	_, err = newclient.Put[map[string]any](client.HttpSession(), path, *values)
+	return err
+}
+
+// MergeValues overwrites the entries of a set of configuration values with those of the input configuration.
+func MergeValues(values map[string]any, configuration any) error {
+	serialized, err := json.Marshal(configuration)
+	if err != nil {
+		return err
+	}
+
+	updatedValues := map[string]any{}
+	if err := json.Unmarshal(serialized, &updatedValues); err != nil {
+		return err
+	}
+
+	for key, value := range updatedValues {
+		values[key] = value
+	}
+
+	return nil
+}
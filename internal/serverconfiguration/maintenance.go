@@ -0,0 +1,24 @@
+package serverconfiguration
+
+import "github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+
+const maintenanceConfigurationPath = "/api/maintenanceconfiguration"
+
+// MaintenanceConfigurationID is the ID of the maintenance configuration of the server.
+const MaintenanceConfigurationID = "maintenance"
+
+// MaintenanceConfiguration determines whether the server is in maintenance mode. In maintenance mode, only system
+// administrators can sign in and no new tasks are started.
+type MaintenanceConfiguration struct {
+	IsInMaintenanceMode bool `json:"IsInMaintenanceMode"`
+}
+
+// GetMaintenanceConfiguration returns the maintenance configuration of the server.
+func GetMaintenanceConfiguration(client newclient.Client) (*MaintenanceConfiguration, error) {
+	return newclient.Get[MaintenanceConfiguration](client.HttpSession(), maintenanceConfigurationPath)
+}
+
+// UpdateMaintenanceConfiguration modifies the maintenance configuration of the server.
+func UpdateMaintenanceConfiguration(client newclient.Client, configuration *MaintenanceConfiguration) error {
+	return update(client, maintenanceConfigurationPath, configuration)
+}
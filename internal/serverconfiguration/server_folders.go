@@ -0,0 +1,24 @@
+package serverconfiguration
+
+import "github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+
+// ServerFoldersConfigurationID is the ID of the server configuration section of the server folders.
+const ServerFoldersConfigurationID = "server-folders"
+
+// ServerFoldersConfiguration contains the folders that the server stores files in. Empty folders are replaced by
+// the default folders, which are located in the home directory of the server.
+type ServerFoldersConfiguration struct {
+	ArtifactsDirectory    string `json:"ArtifactsDirectory"`
+	EventExportsDirectory string `json:"EventExportsDirectory"`
+	TaskLogsDirectory     string `json:"TaskLogsDirectory"`
+}
+
+// GetServerFoldersConfiguration returns the server folders of the server.
+func GetServerFoldersConfiguration(client newclient.Client) (*ServerFoldersConfiguration, error) {
+	return GetConfiguration[ServerFoldersConfiguration](client, ServerFoldersConfigurationID)
+}
+
+// UpdateServerFoldersConfiguration modifies the server folders of the server.
+func UpdateServerFoldersConfiguration(client newclient.Client, configuration *ServerFoldersConfiguration) error {
+	return UpdateConfiguration(client, ServerFoldersConfigurationID, configuration)
+}
@@ -0,0 +1,32 @@
+package serverconfiguration
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/octopusservernodes"
+)
+
+// DefaultMaxConcurrentTasks is the number of tasks that a server node runs at the same time by default.
+const DefaultMaxConcurrentTasks = 5
+
+// ServerNodeTaskCap is the number of tasks that a server node runs at the same time.
+type ServerNodeTaskCap struct {
+	MaxConcurrentTasks int `json:"MaxConcurrentTasks"`
+}
+
+func getServerNodePath(id string) string {
+	return fmt.Sprintf("/api/octopusservernodes/%s", url.PathEscape(id))
+}
+
+// GetServerNode returns the server node that matches the input ID.
+func GetServerNode(client newclient.Client, id string) (*octopusservernodes.OctopusServerNodeResource, error) {
+	return newclient.Get[octopusservernodes.OctopusServerNodeResource](client.HttpSession(), getServerNodePath(id))
+}
+
+// UpdateServerNodeTaskCap modifies the number of tasks that the server node that matches the input ID runs at the
+// same time.
+func UpdateServerNodeTaskCap(client newclient.Client, id string, taskCap *ServerNodeTaskCap) error {
+	return update(client, getServerNodePath(id), taskCap)
+}
@@ -0,0 +1,41 @@
+package serverconfiguration
+
+import (
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+)
+
+const smtpConfigurationPath = "/api/smtpconfiguration"
+
+// SMTPConfigurationID is the ID of the SMTP configuration of the server.
+const SMTPConfigurationID = "smtp"
+
+// SMTPConfiguration is the configuration of the SMTP server used to send emails (i.e. subscription digests).
+type SMTPConfiguration struct {
+	EnableSSL     bool                 `json:"EnableSsl"`
+	SendEmailFrom string               `json:"SendEmailFrom"`
+	SMTPHost      string               `json:"SmtpHost"`
+	SMTPLogin     string               `json:"SmtpLogin"`
+	SMTPPassword  *core.SensitiveValue `json:"SmtpPassword,omitempty"`
+	SMTPPort      int                  `json:"SmtpPort"`
+	Timeout       int                  `json:"Timeout"`
+}
+
+// NewDefaultSMTPConfiguration returns the SMTP configuration of a new server, which does not send emails.
+func NewDefaultSMTPConfiguration() *SMTPConfiguration {
+	return &SMTPConfiguration{
+		SMTPPassword: core.NewSensitiveValue(""),
+		SMTPPort:     25,
+		Timeout:      12000,
+	}
+}
+
+// GetSMTPConfiguration returns the SMTP configuration of the server.
+func GetSMTPConfiguration(client newclient.Client) (*SMTPConfiguration, error) {
+	return newclient.Get[SMTPConfiguration](client.HttpSession(), smtpConfigurationPath)
+}
+
+// UpdateSMTPConfiguration modifies the SMTP configuration of the server.
+func UpdateSMTPConfiguration(client newclient.Client, configuration *SMTPConfiguration) error {
+	return update(client, smtpConfigurationPath, configuration)
+}
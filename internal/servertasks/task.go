@@ -0,0 +1,174 @@
+package servertasks
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tasks"
+)
+
+const (
+	tasksTemplate       = "/api/{spaceId}/tasks{/id}"
+	taskDetailsTemplate = "/api/{spaceId}/tasks/{id}/details{?verbose,tail}"
+)
+
+// Task states that are reported by Octopus Deploy.
+const (
+	TaskStateCanceled = "Canceled"
+	TaskStateFailed   = "Failed"
+	TaskStateSuccess  = "Success"
+	TaskStateTimedOut = "TimedOut"
+)
+
+// ActivityLog is a node of the activity log of a task. The log elements of a node are the messages that were written
+// while the activity ran.
+type ActivityLog struct {
+	Children    []*ActivityLog   `json:"Children"`
+	LogElements []*ActivityEntry `json:"LogElements"`
+	Name        string           `json:"Name"`
+	Status      string           `json:"Status"`
+}
+
+// ActivityEntry is a message of the activity log of a task.
+type ActivityEntry struct {
+	Category    string     `json:"Category"`
+	MessageText string     `json:"MessageText"`
+	OccurredAt  *time.Time `json:"OccurredAt,omitempty"`
+}
+
+// TaskDetails contains a task and its activity log.
+type TaskDetails struct {
+	ActivityLogs []*ActivityLog `json:"ActivityLogs"`
+	Task         *tasks.Task    `json:"Task"`
+}
+
+// Add queues a task.
+func Add(client newclient.Client, spaceID string, task *tasks.Task) (*tasks.Task, error) {
+	return newclient.Add[tasks.Task](client, tasksTemplate, spaceID, task)
+}
+
+// GetByID returns the task that matches the input ID.
+func GetByID(client newclient.Client, spaceID string, id string) (*tasks.Task, error) {
+	return newclient.GetByID[tasks.Task](client, tasksTemplate, spaceID, id)
+}
+
+// GetDetails returns a task with the last messages of its activity log. A tail of zero returns every message.
+func GetDetails(client newclient.Client, spaceID string, id string, tail int) (*TaskDetails, error) {
+	if len(spaceID) == 0 {
+		spaceID = client.GetSpaceID()
+	}
+
+	values := map[string]any{
+		"id":      id,
+		"spaceId": spaceID,
+		"verbose": "true",
+	}
+	if tail > 0 {
+		values["tail"] = strconv.Itoa(tail)
+	}
+
+	path, err := client.URITemplateCache().Expand(taskDetailsTemplate, values)
+	if err != nil {
+		return nil, err
+	}
+
+	return newclient.Get[TaskDetails](client.HttpSession(), path)
+}
+
+// NewHealthCheckTask returns a task that checks the health of the machines (deployment targets or workers) that match
+// the input IDs.
+func NewHealthCheckTask(spaceID string, description string, machineIDs []string, timeout time.Duration) *tasks.Task {
+	task := newMachineTask("Health", spaceID, description, machineIDs)
+	task.Arguments["MachineTimeout"] = FormatTimeSpan(timeout)
+	task.Arguments["Timeout"] = FormatTimeSpan(timeout)
+	return task
+}
+
+// NewTentacleUpgradeTask returns a task that upgrades the tentacles of the machines that match the input IDs to the
+// version that is bundled with the server.
+func NewTentacleUpgradeTask(spaceID string, description string, machineIDs []string) *tasks.Task {
+	return newMachineTask("Upgrade", spaceID, description, machineIDs)
+}
+
+// NewCalamariUpdateTask returns a task that updates Calamari on the machines that match the input IDs.
+func NewCalamariUpdateTask(spaceID string, description string, machineIDs []string) *tasks.Task {
+	return newMachineTask("UpdateCalamari", spaceID, description, machineIDs)
+}
+
+func newMachineTask(name string, spaceID string, description string, machineIDs []string) *tasks.Task {
+	task := tasks.NewTask()
+	task.Name = name
+	task.Description = description
+	task.SpaceID = spaceID
+	task.Arguments["MachineIds"] = machineIDs
+	return task
+}
+
+// IsCompleted reports whether a task has finished, regardless of its outcome.
+func IsCompleted(task *tasks.Task) bool {
+	if task.IsCompleted != nil {
+		return *task.IsCompleted
+	}
+
+	switch task.State {
+	case TaskStateCanceled, TaskStateFailed, TaskStateSuccess, TaskStateTimedOut:
+		return true
+	}
+
+	return false
+}
+
+// FormatTimeSpan formats a duration in the format of a .NET TimeSpan (i.e. [d.]hh:mm:ss).
+func FormatTimeSpan(duration time.Duration) string {
+	if duration < 0 {
+		duration = 0
+	}
+
+	days := duration / (24 * time.Hour)
+	duration -= days * 24 * time.Hour
+	hours := duration / time.Hour
+	duration -= hours * time.Hour
+	minutes := duration / time.Minute
+	duration -= minutes * time.Minute
+	seconds := duration / time.Second
+
+	timeSpan := fmt.Sprintf("%02d:%02d:%02d", hours, minutes, seconds)
+	if days > 0 {
+		timeSpan = fmt.Sprintf("%d.%s", days, timeSpan)
+	}
+
+	return timeSpan
+}
+
+// FormatActivityLogs returns the messages of activity logs as text, with one line per message.
+func FormatActivityLogs(activityLogs []*ActivityLog) string {
+	lines := []string{}
+	for _, activityLog := range activityLogs {
+		lines = appendActivityLog(lines, activityLog)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func appendActivityLog(lines []string, activityLog *ActivityLog) []string {
+	if activityLog == nil {
+		return lines
+	}
+
+	for _, entry := range activityLog.LogElements {
+		if entry == nil {
+			continue
+		}
+
+		lines = append(lines, fmt.Sprintf("%-7s %s", entry.Category, entry.MessageText))
+	}
+
+	for _, child := range activityLog.Children {
+		lines = appendActivityLog(lines, child)
+	}
+
+	return lines
+}
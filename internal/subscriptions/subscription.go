@@ -0,0 +1,108 @@
+package subscriptions
+
+import (
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+)
+
+const subscriptionsTemplate = "/api/{spaceId}/subscriptions{/id}{?skip,take,ids,partialName,spaces}"
+
+// SubscriptionTypeEvent is the type of subscriptions that notify teams of events.
+const SubscriptionTypeEvent = "Event"
+
+// Email priority values of event notification subscriptions.
+const (
+	EmailPriorityHigh   = "High"
+	EmailPriorityNormal = "Normal"
+)
+
+// Subscription sends email digests or webhooks to teams when events that match its filter occur.
+type Subscription struct {
+	EventNotificationSubscription EventNotificationSubscription `json:"EventNotificationSubscription"`
+	IsDisabled                    bool                          `json:"IsDisabled"`
+	Name                          string                        `json:"Name"`
+	SpaceID                       string                        `json:"SpaceId,omitempty"`
+	Type                          string                        `json:"Type"`
+
+	resources.Resource
+}
+
+// EventNotificationSubscription contains the filter of a subscription and the settings of its notifications. Time
+// spans (i.e. EmailFrequencyPeriod and WebhookTimeout) use the .NET format of [d.]hh:mm:ss.
+type EventNotificationSubscription struct {
+	EmailDigestLastProcessed            string                              `json:"EmailDigestLastProcessed,omitempty"`
+	EmailDigestLastProcessedEventAutoID int64                               `json:"EmailDigestLastProcessedEventAutoId,omitempty"`
+	EmailFrequencyPeriod                string                              `json:"EmailFrequencyPeriod,omitempty"`
+	EmailPriority                       string                              `json:"EmailPriority,omitempty"`
+	EmailShowDatesInTimeZoneID          string                              `json:"EmailShowDatesInTimeZoneId,omitempty"`
+	EmailTeams                          []string                            `json:"EmailTeams"`
+	Filter                              EventNotificationSubscriptionFilter `json:"Filter"`
+	WebhookHeaderKey                    string                              `json:"WebhookHeaderKey,omitempty"`
+	WebhookHeaderValue                  string                              `json:"WebhookHeaderValue,omitempty"`
+	WebhookLastProcessed                string                              `json:"WebhookLastProcessed,omitempty"`
+	WebhookLastProcessedEventAutoID     int64                               `json:"WebhookLastProcessedEventAutoId,omitempty"`
+	WebhookTeams                        []string                            `json:"WebhookTeams"`
+	WebhookTimeout                      string                              `json:"WebhookTimeout,omitempty"`
+	WebhookURI                          string                              `json:"WebhookURI,omitempty"`
+}
+
+// EventNotificationSubscriptionFilter determines which events a subscription sends notifications for. Empty lists
+// match all events.
+type EventNotificationSubscriptionFilter struct {
+	DocumentTypes   []string `json:"DocumentTypes"`
+	Environments    []string `json:"Environments"`
+	EventAgents     []string `json:"EventAgents"`
+	EventCategories []string `json:"EventCategories"`
+	EventGroups     []string `json:"EventGroups"`
+	ProjectGroups   []string `json:"ProjectGroups"`
+	Projects        []string `json:"Projects"`
+	Tags            []string `json:"Tags"`
+	Tenants         []string `json:"Tenants"`
+	Users           []string `json:"Users"`
+}
+
+// NewSubscription creates and initializes an event notification subscription.
+func NewSubscription(name string) *Subscription {
+	return &Subscription{
+		EventNotificationSubscription: EventNotificationSubscription{
+			EmailPriority: EmailPriorityNormal,
+			EmailTeams:    []string{},
+			Filter: EventNotificationSubscriptionFilter{
+				DocumentTypes:   []string{},
+				Environments:    []string{},
+				EventAgents:     []string{},
+				EventCategories: []string{},
+				EventGroups:     []string{},
+				ProjectGroups:   []string{},
+				Projects:        []string{},
+				Tags:            []string{},
+				Tenants:         []string{},
+				Users:           []string{},
+			},
+			WebhookTeams: []string{},
+		},
+		Name:     name,
+		Type:     SubscriptionTypeEvent,
+		Resource: *resources.NewResource(),
+	}
+}
+
+// Add creates a new subscription.
+func Add(client newclient.Client, subscription *Subscription) (*Subscription, error) {
+	return newclient.Add[Subscription](client, subscriptionsTemplate, subscription.SpaceID, subscription)
+}
+
+// DeleteByID deletes the subscription that matches the input ID.
+func DeleteByID(client newclient.Client, spaceID string, id string) error {
+	return newclient.DeleteByID(client, subscriptionsTemplate, spaceID, id)
+}
+
+// GetByID returns the subscription that matches the input ID.
+func GetByID(client newclient.Client, spaceID string, id string) (*Subscription, error) {
+	return newclient.GetByID[Subscription](client, subscriptionsTemplate, spaceID, id)
+}
+
+// Update modifies a subscription based on the one provided as input.
+func Update(client newclient.Client, subscription *Subscription) (*Subscription, error) {
+	return newclient.Update[Subscription](client, subscriptionsTemplate, subscription.SpaceID, subscription.GetID(), subscription)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/oidcaccounts"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
@@ -35,3 +36,33 @@ func testAccountCheckDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func testOpenIDConnectAccountExists(prefix string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*client.Client)
+		rs := s.RootModule().Resources[prefix]
+		if _, err := oidcaccounts.GetByID[oidcaccounts.Account](client, rs.Primary.Attributes["space_id"], rs.Primary.ID); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testOpenIDConnectAccountCheckDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*client.Client)
+	for _, rs := range s.RootModule().Resources {
+		switch rs.Type {
+		case "octopusdeploy_aws_openid_connect_account", "octopusdeploy_azure_openid_connect", "octopusdeploy_gcp_openid_connect_account":
+		default:
+			continue
+		}
+
+		account, err := oidcaccounts.GetByID[oidcaccounts.Account](client, rs.Primary.Attributes["space_id"], rs.Primary.ID)
+		if err == nil && account != nil {
+			return fmt.Errorf("account (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
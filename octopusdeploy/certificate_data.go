@@ -0,0 +1,180 @@
+package octopusdeploy
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Key algorithms of generated certificates.
+const (
+	certificateKeyAlgorithmECDSAP256 = "ECDSA_P256"
+	certificateKeyAlgorithmECDSAP384 = "ECDSA_P384"
+	certificateKeyAlgorithmRSA2048   = "RSA_2048"
+	certificateKeyAlgorithmRSA4096   = "RSA_4096"
+)
+
+// certificateGeneration describes a self-signed certificate that is generated for the certificate resource.
+type certificateGeneration struct {
+	CommonName   string
+	KeyAlgorithm string
+	SANs         []string
+	ValidityDays int
+}
+
+func expandCertificateGeneration(v interface{}) *certificateGeneration {
+	flattenedValues, ok := v.([]interface{})
+	if !ok || len(flattenedValues) == 0 || flattenedValues[0] == nil {
+		return nil
+	}
+
+	flattenedMap := flattenedValues[0].(map[string]interface{})
+
+	return &certificateGeneration{
+		CommonName:   flattenedMap["common_name"].(string),
+		KeyAlgorithm: flattenedMap["key_algorithm"].(string),
+		SANs:         getSliceFromTerraformTypeList(flattenedMap["sans"]),
+		ValidityDays: flattenedMap["validity_days"].(int),
+	}
+}
+
+// getCertificateData returns the base64-encoded data of the certificate that is uploaded to Octopus Deploy: the
+// configured certificate_data as is, the concatenated PEM blocks, or a newly generated self-signed certificate.
+func getCertificateData(d *schema.ResourceData) (string, error) {
+	if generation := expandCertificateGeneration(d.Get("generate")); generation != nil {
+		bundle, err := generateSelfSignedCertificate(generation, time.Now().UTC())
+		if err != nil {
+			return "", err
+		}
+
+		return base64.StdEncoding.EncodeToString([]byte(bundle)), nil
+	}
+
+	if certificatePEM := d.Get("certificate_pem").(string); len(certificatePEM) > 0 {
+		bundle := getCertificatePEMBundle(certificatePEM, d.Get("certificate_chain_pem").(string), d.Get("private_key_pem").(string))
+		return base64.StdEncoding.EncodeToString([]byte(bundle)), nil
+	}
+
+	return d.Get("certificate_data").(string), nil
+}
+
+// getCertificatePEMBundle concatenates the PEM blocks of a certificate in the order that Octopus Deploy expects: the
+// certificate first, followed by its chain and its private key.
+func getCertificatePEMBundle(certificatePEM string, chainPEM string, privateKeyPEM string) string {
+	blocks := []string{}
+	for _, block := range []string{certificatePEM, chainPEM, privateKeyPEM} {
+		if block = strings.TrimSpace(block); len(block) > 0 {
+			blocks = append(blocks, block)
+		}
+	}
+
+	return strings.Join(blocks, "\n") + "\n"
+}
+
+// generateSelfSignedCertificate returns the PEM blocks of a new self-signed certificate and its private key.
+func generateSelfSignedCertificate(generation *certificateGeneration, now time.Time) (string, error) {
+	var privateKey crypto.Signer
+	var err error
+	switch generation.KeyAlgorithm {
+	case certificateKeyAlgorithmECDSAP256:
+		privateKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case certificateKeyAlgorithmECDSAP384:
+		privateKey, err = ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	case certificateKeyAlgorithmRSA2048:
+		privateKey, err = rsa.GenerateKey(rand.Reader, 2048)
+	case certificateKeyAlgorithmRSA4096:
+		privateKey, err = rsa.GenerateKey(rand.Reader, 4096)
+	default:
+		return "", fmt.Errorf("unsupported key algorithm: %s", generation.KeyAlgorithm)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return "", err
+	}
+
+	template := &x509.Certificate{
+		BasicConstraintsValid: true,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		NotAfter:              now.AddDate(0, 0, generation.ValidityDays),
+		NotBefore:             now,
+		SerialNumber:          serialNumber,
+		Subject:               pkix.Name{CommonName: generation.CommonName},
+	}
+
+	if _, ok := privateKey.(*rsa.PrivateKey); ok {
+		template.KeyUsage |= x509.KeyUsageKeyEncipherment
+	}
+
+	for _, san := range generation.SANs {
+		if ip := net.ParseIP(san); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, san)
+		}
+	}
+
+	certificateDER, err := x509.CreateCertificate(rand.Reader, template, template, privateKey.Public(), privateKey)
+	if err != nil {
+		return "", err
+	}
+
+	privateKeyDER, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return "", err
+	}
+
+	certificatePEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificateDER})
+	privateKeyPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateKeyDER})
+
+	return getCertificatePEMBundle(string(certificatePEM), "", string(privateKeyPEM)), nil
+}
+
+// validatePEMBlocks returns a function that validates that a value only consists of PEM blocks whose types end with
+// the input suffix (i.e. `CERTIFICATE` or `PRIVATE KEY`).
+func validatePEMBlocks(typeSuffix string) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) ([]string, []error) {
+		v, ok := i.(string)
+		if !ok {
+			return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+		}
+
+		rest := []byte(strings.TrimSpace(v))
+		if len(rest) == 0 {
+			return nil, nil
+		}
+
+		for len(rest) > 0 {
+			var block *pem.Block
+			block, rest = pem.Decode(rest)
+			if block == nil {
+				return nil, []error{fmt.Errorf("expected %q to only contain PEM-encoded blocks", k)}
+			}
+
+			if !strings.HasSuffix(block.Type, typeSuffix) {
+				return nil, []error{fmt.Errorf("expected %q to only contain %s blocks, got a %s block", k, typeSuffix, block.Type)}
+			}
+
+			rest = []byte(strings.TrimSpace(string(rest)))
+		}
+
+		return nil, nil
+	}
+}
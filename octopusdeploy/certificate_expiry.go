@@ -0,0 +1,89 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/certificates"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// getCertificateExpiryDiagnostics returns a warning when the certificate expires within its warn_before_expiry window.
+// Read returns it as well, so the warning is part of every plan that refreshes the certificate.
+func getCertificateExpiryDiagnostics(d *schema.ResourceData) diag.Diagnostics {
+	v, ok := d.GetOk("warn_before_expiry")
+	if !ok {
+		return nil
+	}
+
+	window, err := time.ParseDuration(v.(string))
+	if err != nil {
+		return nil
+	}
+
+	notAfter, err := time.Parse(time.RFC3339, d.Get("not_after").(string))
+	if err != nil {
+		log.Printf("[WARN] unable to parse the expiry date of certificate (%s): %s", d.Id(), err)
+		return nil
+	}
+
+	now := time.Now().UTC()
+	if !isCertificateExpiring(notAfter, window, now) {
+		return nil
+	}
+
+	summary := fmt.Sprintf("certificate %q expires on %s", d.Get("name").(string), notAfter.Format(time.RFC3339))
+	if notAfter.Before(now) {
+		summary = fmt.Sprintf("certificate %q expired on %s", d.Get("name").(string), notAfter.Format(time.RFC3339))
+	}
+
+	return diag.Diagnostics{{
+		Severity:      diag.Warning,
+		Summary:       summary,
+		Detail:        fmt.Sprintf("The certificate expires within the warn_before_expiry window of %s. Update its data (with replace_in_place set to keep its ID) before it expires.", window),
+		AttributePath: cty.GetAttrPath("not_after"),
+	}}
+}
+
+// getExpiringCertificates returns the certificates that expire before the end of the window, ordered by their expiry
+// dates. Certificates that have already expired are only returned when includeExpired is set.
+func getExpiringCertificates(existingCertificates []*certificates.CertificateResource, window time.Duration, includeExpired bool, now time.Time) []*certificates.CertificateResource {
+	type expiringCertificate struct {
+		certificate *certificates.CertificateResource
+		notAfter    time.Time
+	}
+
+	expiringCertificates := []expiringCertificate{}
+	for _, certificate := range existingCertificates {
+		notAfter, err := time.Parse(time.RFC3339, certificate.NotAfter)
+		if err != nil {
+			log.Printf("[WARN] unable to parse the expiry date of certificate (%s): %s", certificate.GetID(), err)
+			continue
+		}
+
+		if !isCertificateExpiring(notAfter, window, now) || (!includeExpired && notAfter.Before(now)) {
+			continue
+		}
+
+		expiringCertificates = append(expiringCertificates, expiringCertificate{certificate: certificate, notAfter: notAfter})
+	}
+
+	sort.SliceStable(expiringCertificates, func(i, j int) bool {
+		return expiringCertificates[i].notAfter.Before(expiringCertificates[j].notAfter)
+	})
+
+	results := []*certificates.CertificateResource{}
+	for _, expiringCertificate := range expiringCertificates {
+		results = append(results, expiringCertificate.certificate)
+	}
+
+	return results
+}
+
+func isCertificateExpiring(notAfter time.Time, window time.Duration, now time.Time) bool {
+	return !notAfter.After(now.Add(window))
+}
@@ -0,0 +1,67 @@
+package octopusdeploy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/certificates"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCertificateExpiryDiagnostics(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, getCertificateSchema(), map[string]interface{}{
+		"name":      "Web",
+		"not_after": time.Now().UTC().Add(10 * 24 * time.Hour).Format(time.RFC3339),
+	})
+	require.Empty(t, getCertificateExpiryDiagnostics(d))
+
+	d.Set("warn_before_expiry", "168h")
+	require.Empty(t, getCertificateExpiryDiagnostics(d))
+
+	d.Set("warn_before_expiry", "720h")
+	diags := getCertificateExpiryDiagnostics(d)
+	require.Len(t, diags, 1)
+	require.Equal(t, diag.Warning, diags[0].Severity)
+	require.Contains(t, diags[0].Summary, `certificate "Web" expires on`)
+
+	d.Set("not_after", time.Now().UTC().Add(-time.Hour).Format(time.RFC3339))
+	diags = getCertificateExpiryDiagnostics(d)
+	require.Len(t, diags, 1)
+	require.Contains(t, diags[0].Summary, `certificate "Web" expired on`)
+
+	d.Set("not_after", "")
+	require.Empty(t, getCertificateExpiryDiagnostics(d))
+}
+
+func TestGetExpiringCertificates(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	newCertificate := func(id string, notAfter string) *certificates.CertificateResource {
+		certificate := certificates.NewCertificateResource(id, nil, nil)
+		certificate.ID = id
+		certificate.NotAfter = notAfter
+		return certificate
+	}
+
+	existingCertificates := []*certificates.CertificateResource{
+		newCertificate("Certificates-1", "2024-06-20T00:00:00+00:00"),
+		newCertificate("Certificates-2", "2024-05-01T00:00:00+00:00"),
+		newCertificate("Certificates-3", "2025-01-01T00:00:00+00:00"),
+		newCertificate("Certificates-4", "2024-06-10T12:30:00.000+00:00"),
+		newCertificate("Certificates-5", ""),
+	}
+
+	getIDs := func(expiringCertificates []*certificates.CertificateResource) []string {
+		ids := []string{}
+		for _, certificate := range expiringCertificates {
+			ids = append(ids, certificate.GetID())
+		}
+		return ids
+	}
+
+	require.Equal(t, []string{"Certificates-2", "Certificates-4", "Certificates-1"}, getIDs(getExpiringCertificates(existingCertificates, 30*24*time.Hour, true, now)))
+	require.Equal(t, []string{"Certificates-4", "Certificates-1"}, getIDs(getExpiringCertificates(existingCertificates, 30*24*time.Hour, false, now)))
+	require.Equal(t, []string{"Certificates-2"}, getIDs(getExpiringCertificates(existingCertificates, time.Hour, true, now)))
+}
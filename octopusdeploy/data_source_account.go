@@ -0,0 +1,47 @@
+package octopusdeploy
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/accounts"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/oidcaccounts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAccount() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides information about an existing account, which is looked up by its exact name or ID.",
+		ReadContext: dataSourceAccountRead,
+		Schema:      getLookupDataSchema("account", getAccountResourceSchema()),
+	}
+}
+
+func dataSourceAccountRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return lookupDataSourceRead(ctx, d, m, "account", searchAccounts)
+}
+
+func searchAccounts(ctx context.Context, client *client.Client, spaceID string, ids []string, partialName string) ([]map[string]interface{}, error) {
+	query := accounts.AccountsQuery{
+		IDs:         ids,
+		PartialName: partialName,
+	}
+
+	existingAccounts, err := oidcaccounts.Get(client, spaceID, &query)
+	if err != nil {
+		return nil, err
+	}
+
+	nextAccounts, err := getNextPages[*accounts.AccountResource](ctx, client, existingAccounts.PagedResults)
+	if err != nil {
+		return nil, err
+	}
+
+	flattenedAccounts := []map[string]interface{}{}
+	for _, accountResource := range append(existingAccounts.Items, nextAccounts...) {
+		flattenedAccounts = append(flattenedAccounts, flattenAccountResource(accountResource))
+	}
+
+	return flattenedAccounts, nil
+}
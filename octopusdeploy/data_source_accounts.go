@@ -6,13 +6,14 @@ import (
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/accounts"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/oidcaccounts"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceAccounts() *schema.Resource {
 	return &schema.Resource{
-		DeprecationMessage: "Use an account-specific resource instead (i.e. octopusdeploy_aws_account, octopusdeploy_azure_service_principal, octopusdeploy_azure_subscription_account, octopusdeploy_ssh_key_account, octopusdeploy_token_account, octopusdeploy_username_password_account).",
+		DeprecationMessage: "Use an account-specific resource instead (i.e. octopusdeploy_aws_account, octopusdeploy_aws_openid_connect_account, octopusdeploy_azure_openid_connect, octopusdeploy_azure_service_principal, octopusdeploy_azure_subscription_account, octopusdeploy_gcp_account, octopusdeploy_gcp_openid_connect_account, octopusdeploy_ssh_key_account, octopusdeploy_token_account, octopusdeploy_username_password_account).",
 		Description:        "Provides information about existing accounts.",
 		ReadContext:        dataSourceAccountsRead,
 		Schema:             getAccountResourceDataSchema(),
@@ -30,18 +31,18 @@ func dataSourceAccountsRead(ctx context.Context, d *schema.ResourceData, m inter
 	spaceID := d.Get("space_id").(string)
 
 	client := m.(*client.Client)
-	existingAccounts, err := accounts.Get(client, spaceID, &query)
+	existingAccounts, err := oidcaccounts.Get(client, spaceID, &query)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	flattenedAccounts := []interface{}{}
-	for _, account := range existingAccounts.Items {
-		accountResource, err := accounts.ToAccountResource(account)
-		if err != nil {
-			return diag.FromErr(err)
-		}
+	existingAccounts.Items, err = getAllPages(ctx, d, client, existingAccounts)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
+	flattenedAccounts := []interface{}{}
+	for _, accountResource := range existingAccounts.Items {
 		flattenedAccounts = append(flattenedAccounts, flattenAccountResource(accountResource))
 	}
 
@@ -42,6 +42,11 @@ func dataSourceAzureCloudServiceDeploymentTargetsRead(ctx context.Context, d *sc
 		return diag.FromErr(err)
 	}
 
+	existingDeploymentTargets.Items, err = getAllPages(ctx, d, client, existingDeploymentTargets)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	flattenedAzureCloudServiceDeploymentTargets := []interface{}{}
 	for _, deploymentTarget := range existingDeploymentTargets.Items {
 		flattenedAzureCloudServiceDeploymentTargets = append(flattenedAzureCloudServiceDeploymentTargets, flattenAzureCloudServiceDeploymentTarget(deploymentTarget))
@@ -43,6 +43,11 @@ func dataSourceAzureServiceFabricClusterDeploymentTargetsRead(ctx context.Contex
 		return diag.FromErr(err)
 	}
 
+	existingDeploymentTargets.Items, err = getAllPages(ctx, d, client, existingDeploymentTargets)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	flattenedAzureServiceFabricClusterDeploymentTargets := []interface{}{}
 	for _, deploymentTarget := range existingDeploymentTargets.Items {
 		flattenedAzureServiceFabricClusterDeploymentTargets = append(flattenedAzureServiceFabricClusterDeploymentTargets, flattenAzureServiceFabricClusterDeploymentTarget(deploymentTarget))
@@ -43,6 +43,11 @@ func dataSourceAzureWebAppDeploymentTargetsRead(ctx context.Context, d *schema.R
 		return diag.FromErr(err)
 	}
 
+	existingDeploymentTargets.Items, err = getAllPages(ctx, d, client, existingDeploymentTargets)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	flattenedAzureWebAppDeploymentTargets := []interface{}{}
 	for _, deploymentTarget := range existingDeploymentTargets.Items {
 		flattenedAzureWebAppDeploymentTargets = append(flattenedAzureWebAppDeploymentTargets, flattenAzureWebAppDeploymentTarget(deploymentTarget))
@@ -38,6 +38,11 @@ func dataSourceCertificatesRead(ctx context.Context, d *schema.ResourceData, m i
 		return diag.FromErr(err)
 	}
 
+	existingCertificates.Items, err = getAllPages(ctx, d, client, existingCertificates)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	flattenedCertificates := []interface{}{}
 	for _, certificate := range existingCertificates.Items {
 		flattenedCertificates = append(flattenedCertificates, flattenCertificate(certificate))
@@ -33,6 +33,11 @@ func dataSourceChannelsRead(ctx context.Context, d *schema.ResourceData, m inter
 		return diag.FromErr(err)
 	}
 
+	existingChannels.Items, err = getAllPages(ctx, d, client, existingChannels)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	flattenedChannels := []interface{}{}
 	for _, channel := range existingChannels.Items {
 		flattenedChannels = append(flattenedChannels, flattenChannel(channel))
@@ -43,6 +43,11 @@ func dataSourceCloudRegionDeploymentTargetsRead(ctx context.Context, d *schema.R
 		return diag.FromErr(err)
 	}
 
+	existingDeploymentTargets.Items, err = getAllPages(ctx, d, client, existingDeploymentTargets)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	flattenedCloudRegionDeploymentTargets := []interface{}{}
 	for _, deploymentTarget := range existingDeploymentTargets.Items {
 		flattenedCloudRegionDeploymentTargets = append(flattenedCloudRegionDeploymentTargets, flattenCloudRegionDeploymentTarget(deploymentTarget))
@@ -14,7 +14,7 @@ func dataSourceDeploymentTargets() *schema.Resource {
 	return &schema.Resource{
 		Description: "Provides information about existing deployment targets.",
 		ReadContext: dataSourceDeploymentTargetsRead,
-		Schema:      getDeploymentTargetDataSchema(),
+		Schema:      getDeploymentTargetsDataSchema(),
 	}
 }
 
@@ -43,28 +43,22 @@ func dataSourceDeploymentTargetsRead(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 
-	flattenedDeploymentTargets := []interface{}{}
-	// flattenedListeningTentacleDeploymentTargets := []interface{}{}
-	// flattenedOfflinePackageDropDeploymentTargets := []interface{}{}
-	// flattenedPollingTentacleDeploymentTargets := []interface{}{}
+	existingDeploymentTargets.Items, err = getAllPages(ctx, d, client, existingDeploymentTargets)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
+	flattenedDeploymentTargets := []interface{}{}
 	for _, deploymentTarget := range existingDeploymentTargets.Items {
-		// 	switch deploymentTarget.Endpoint.GetCommunicationStyle() {
-		// 	case "OfflineDrop":
-		// 		flattenedOfflinePackageDropDeploymentTargets = append(flattenedOfflinePackageDropDeploymentTargets, flattenOfflinePackageDropDeploymentTarget(deploymentTarget))
-		// 	case "TentacleActive":
-		// 		flattenedPollingTentacleDeploymentTargets = append(flattenedPollingTentacleDeploymentTargets, flattenPollingTentacleDeploymentTarget(deploymentTarget))
-		// 	case "TentaclePassive":
-		// 		flattenedListeningTentacleDeploymentTargets = append(flattenedListeningTentacleDeploymentTargets, flattenListeningTentacleDeploymentTarget(deploymentTarget))
-		// 	default:
 		flattenedDeploymentTargets = append(flattenedDeploymentTargets, flattenDeploymentTarget(deploymentTarget))
-		// 	}
 	}
 
 	d.Set("deployment_targets", flattenedDeploymentTargets)
-	// d.Set("listening_tentacle_deployment_targets", flattenedListeningTentacleDeploymentTargets)
-	// d.Set("offline_package_drop_deployment_targets", flattenedOfflinePackageDropDeploymentTargets)
-	// d.Set("polling_tentacle_deployment_targets", flattenedPollingTentacleDeploymentTargets)
+	for key, flattenedTypedDeploymentTargets := range flattenTypedDeploymentTargets(existingDeploymentTargets.Items) {
+		if err := d.Set(key, flattenedTypedDeploymentTargets); err != nil {
+			return diag.Errorf("error setting %s: %s", key, err)
+		}
+	}
 
 	d.SetId("DeploymentTargets " + time.Now().UTC().String())
 
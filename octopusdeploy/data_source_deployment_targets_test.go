@@ -0,0 +1,43 @@
+package octopusdeploy
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/machines"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenTypedDeploymentTargets(t *testing.T) {
+	listeningURL, _ := url.Parse("https://listening.example.com:10933")
+	pollingURL, _ := url.Parse("poll://abcdefghijklmnopqrst/")
+
+	listeningTentacle := machines.NewDeploymentTarget("listening", machines.NewListeningTentacleEndpoint(listeningURL, "thumbprint"), []string{"Environments-1"}, []string{"web"})
+	listeningTentacle.ID = "Machines-1"
+	pollingTentacle := machines.NewDeploymentTarget("polling", machines.NewPollingTentacleEndpoint(pollingURL, "thumbprint"), []string{"Environments-1"}, []string{"web"})
+	pollingTentacle.ID = "Machines-2"
+	sshConnection := machines.NewDeploymentTarget("ssh", machines.NewSSHEndpoint("ssh.example.com", 22, "fingerprint"), []string{"Environments-1"}, []string{"web"})
+	sshConnection.ID = "Machines-3"
+	cloudRegion := machines.NewDeploymentTarget("cloud region", machines.NewCloudRegionEndpoint(), []string{"Environments-1"}, []string{"web"})
+	cloudRegion.ID = "Machines-4"
+
+	flattenedDeploymentTargets := flattenTypedDeploymentTargets([]*machines.DeploymentTarget{listeningTentacle, pollingTentacle, sshConnection, cloudRegion})
+	require.Len(t, flattenedDeploymentTargets, len(getTypedDeploymentTargetLists()))
+	require.Len(t, flattenedDeploymentTargets["listening_tentacle_deployment_targets"], 1)
+	require.Len(t, flattenedDeploymentTargets["polling_tentacle_deployment_targets"], 1)
+	require.Len(t, flattenedDeploymentTargets["ssh_connection_deployment_targets"], 1)
+	require.Len(t, flattenedDeploymentTargets["cloud_region_deployment_targets"], 1)
+	require.Empty(t, flattenedDeploymentTargets["kubernetes_cluster_deployment_targets"])
+
+	d := schema.TestResourceDataRaw(t, getDeploymentTargetsDataSchema(), map[string]interface{}{})
+	for key, value := range flattenedDeploymentTargets {
+		require.NoError(t, d.Set(key, value))
+	}
+
+	require.Equal(t, "https://listening.example.com:10933", d.Get("listening_tentacle_deployment_targets.0.tentacle_url"))
+	require.Equal(t, "Machines-2", d.Get("polling_tentacle_deployment_targets.0.id"))
+	require.Equal(t, "ssh.example.com", d.Get("ssh_connection_deployment_targets.0.host"))
+	require.Equal(t, 22, d.Get("ssh_connection_deployment_targets.0.port"))
+	require.Equal(t, "cloud region", d.Get("cloud_region_deployment_targets.0.name"))
+}
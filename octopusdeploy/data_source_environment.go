@@ -0,0 +1,46 @@
+package octopusdeploy
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/environments"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceEnvironment() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides information about an existing environment, which is looked up by its exact name or ID.",
+		ReadContext: dataSourceEnvironmentRead,
+		Schema:      getLookupDataSchema("environment", getEnvironmentSchema()),
+	}
+}
+
+func dataSourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return lookupDataSourceRead(ctx, d, m, "environment", searchEnvironments)
+}
+
+func searchEnvironments(ctx context.Context, client *client.Client, spaceID string, ids []string, partialName string) ([]map[string]interface{}, error) {
+	query := environments.EnvironmentsQuery{
+		IDs:         ids,
+		PartialName: partialName,
+	}
+
+	existingEnvironments, err := environments.Get(client, spaceID, query)
+	if err != nil {
+		return nil, err
+	}
+
+	nextEnvironments, err := getNextPages[*environments.Environment](ctx, client, existingEnvironments.PagedResults)
+	if err != nil {
+		return nil, err
+	}
+
+	flattenedEnvironments := []map[string]interface{}{}
+	for _, environment := range append(existingEnvironments.Items, nextEnvironments...) {
+		flattenedEnvironments = append(flattenedEnvironments, flattenEnvironment(environment))
+	}
+
+	return flattenedEnvironments, nil
+}
@@ -33,6 +33,11 @@ func dataSourceEnvironmentsRead(ctx context.Context, d *schema.ResourceData, m i
 		return diag.FromErr(err)
 	}
 
+	existingEnvironments.Items, err = getAllPages(ctx, d, client, existingEnvironments)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	flattenedEnvironments := []interface{}{}
 	for _, environment := range existingEnvironments.Items {
 		flattenedEnvironments = append(flattenedEnvironments, flattenEnvironment(environment))
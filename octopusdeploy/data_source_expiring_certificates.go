@@ -0,0 +1,49 @@
+package octopusdeploy
+
+import (
+	"context"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/certificates"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceExpiringCertificates() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides information about the certificates that expire within a duration, ordered by their expiry dates. Archived certificates are not included.",
+		ReadContext: dataSourceExpiringCertificatesRead,
+		Schema:      getExpiringCertificatesDataSchema(),
+	}
+}
+
+func dataSourceExpiringCertificatesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	within, err := time.ParseDuration(d.Get("within").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := m.(*client.Client)
+	existingCertificates, err := certificates.Get(client, d.Get("space_id").(string), certificates.CertificatesQuery{Archived: "false"})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	nextCertificates, err := getNextPages[*certificates.CertificateResource](ctx, client, existingCertificates.PagedResults)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	expiringCertificates := getExpiringCertificates(append(existingCertificates.Items, nextCertificates...), within, d.Get("include_expired").(bool), time.Now().UTC())
+
+	flattenedCertificates := []interface{}{}
+	for _, certificate := range expiringCertificates {
+		flattenedCertificates = append(flattenedCertificates, flattenCertificate(certificate))
+	}
+
+	d.Set("certificates", flattenedCertificates)
+	d.SetId("ExpiringCertificates " + time.Now().UTC().String())
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package octopusdeploy
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/feeds"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFeed() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides information about an existing feed, which is looked up by its exact name or ID.",
+		ReadContext: dataSourceFeedRead,
+		Schema:      getLookupDataSchema("feed", getFeedSchema()),
+	}
+}
+
+func dataSourceFeedRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return lookupDataSourceRead(ctx, d, m, "feed", searchFeeds)
+}
+
+func searchFeeds(ctx context.Context, client *client.Client, spaceID string, ids []string, partialName string) ([]map[string]interface{}, error) {
+	query := feeds.FeedsQuery{
+		IDs:         ids,
+		PartialName: partialName,
+	}
+
+	existingFeeds, err := feeds.Get(client, spaceID, query)
+	if err != nil {
+		return nil, err
+	}
+
+	flattenedFeeds := []map[string]interface{}{}
+	for _, feed := range existingFeeds.Items {
+		feedResource, err := feeds.ToFeedResource(feed)
+		if err != nil {
+			return nil, err
+		}
+
+		flattenedFeeds = append(flattenedFeeds, flattenFeed(feedResource))
+	}
+
+	nextFeeds, err := getNextPages[*feeds.FeedResource](ctx, client, existingFeeds.PagedResults)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, feedResource := range nextFeeds {
+		flattenedFeeds = append(flattenedFeeds, flattenFeed(feedResource))
+	}
+
+	return flattenedFeeds, nil
+}
@@ -44,6 +44,17 @@ func dataSourceFeedsRead(ctx context.Context, d *schema.ResourceData, m interfac
 		flattenedFeeds = append(flattenedFeeds, flattenFeed(feedResource))
 	}
 
+	if isAllPagesQuery(d) {
+		nextFeeds, err := getNextPages[*feeds.FeedResource](ctx, client, existingFeeds.PagedResults)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, feedResource := range nextFeeds {
+			flattenedFeeds = append(flattenedFeeds, flattenFeed(feedResource))
+		}
+	}
+
 	d.Set("feeds", flattenedFeeds)
 	d.SetId("Feeds " + time.Now().UTC().String())
 
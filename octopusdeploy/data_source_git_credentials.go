@@ -32,6 +32,11 @@ func dataSourceGitCredentialsRead(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
+	existingGitCredentials.Items, err = getAllPages(ctx, d, client, existingGitCredentials)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	flattenedGitCredentials := []interface{}{}
 	for _, gitCredential := range existingGitCredentials.Items {
 		flattenedGitCredentials = append(flattenedGitCredentials, flattenGitCredential(gitCredential))
@@ -0,0 +1,43 @@
+package octopusdeploy
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/insights"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceInsightsMetrics() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides the DORA metric series (deployment frequency, lead time, change failure rate and time to recovery) calculated by an insights report for a time window.",
+		ReadContext: dataSourceInsightsMetricsRead,
+		Schema:      getInsightsMetricsDataSchema(),
+	}
+}
+
+func dataSourceInsightsMetricsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	query := insights.MetricsQuery{
+		EndTime:     d.Get("end_time").(string),
+		Granularity: d.Get("granularity").(string),
+		StartTime:   d.Get("start_time").(string),
+	}
+
+	reportID := d.Get("report_id").(string)
+	spaceID := d.Get("space_id").(string)
+
+	client := m.(*client.Client)
+	metrics, err := insights.GetMetrics(client, spaceID, reportID, query)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("change_failure_rate", flattenInsightsMetricDataPoints(metrics.ChangeFailureRate))
+	d.Set("deployment_frequency", flattenInsightsMetricDataPoints(metrics.DeploymentFrequency))
+	d.Set("lead_time", flattenInsightsMetricDataPoints(metrics.LeadTime))
+	d.Set("mean_time_to_recovery", flattenInsightsMetricDataPoints(metrics.MeanTimeToRecovery))
+	d.SetId("InsightsMetrics " + reportID + " " + query.StartTime + " " + query.EndTime)
+
+	return nil
+}
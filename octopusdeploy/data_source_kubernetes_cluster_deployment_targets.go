@@ -43,6 +43,11 @@ func dataSourceKubernetesClusterDeploymentTargetsRead(ctx context.Context, d *sc
 		return diag.FromErr(err)
 	}
 
+	existingDeploymentTargets.Items, err = getAllPages(ctx, d, client, existingDeploymentTargets)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	flattenedKubernetesClusterDeploymentTargets := []interface{}{}
 	for _, deploymentTarget := range existingDeploymentTargets.Items {
 		flattenedKubernetesClusterDeploymentTargets = append(flattenedKubernetesClusterDeploymentTargets, flattenKubernetesClusterDeploymentTarget(deploymentTarget))
@@ -36,6 +36,11 @@ func dataSourceLibraryVariableSetReadByName(ctx context.Context, d *schema.Resou
 		return diag.FromErr(err)
 	}
 
+	existingLibraryVariableSets.Items, err = getAllPages(ctx, d, client, existingLibraryVariableSets)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	flattenedLibraryVariableSets := []interface{}{}
 	for _, libraryVariableSet := range existingLibraryVariableSets.Items {
 		flattenedLibraryVariableSets = append(flattenedLibraryVariableSets, flattenLibraryVariableSet(libraryVariableSet))
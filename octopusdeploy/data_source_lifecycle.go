@@ -0,0 +1,46 @@
+package octopusdeploy
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/lifecycles"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceLifecycle() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides information about an existing lifecycle, which is looked up by its exact name or ID.",
+		ReadContext: dataSourceLifecycleRead,
+		Schema:      getLookupDataSchema("lifecycle", getLifecycleSchema()),
+	}
+}
+
+func dataSourceLifecycleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return lookupDataSourceRead(ctx, d, m, "lifecycle", searchLifecycles)
+}
+
+func searchLifecycles(ctx context.Context, client *client.Client, spaceID string, ids []string, partialName string) ([]map[string]interface{}, error) {
+	query := lifecycles.Query{
+		IDs:         ids,
+		PartialName: partialName,
+	}
+
+	existingLifecycles, err := lifecycles.Get(client, spaceID, query)
+	if err != nil {
+		return nil, err
+	}
+
+	nextLifecycles, err := getNextPages[*lifecycles.Lifecycle](ctx, client, existingLifecycles.PagedResults)
+	if err != nil {
+		return nil, err
+	}
+
+	flattenedLifecycles := []map[string]interface{}{}
+	for _, lifecycle := range append(existingLifecycles.Items, nextLifecycles...) {
+		flattenedLifecycles = append(flattenedLifecycles, flattenLifecycle(lifecycle))
+	}
+
+	return flattenedLifecycles, nil
+}
@@ -33,6 +33,11 @@ func dataSourceLifecyclesRead(ctx context.Context, d *schema.ResourceData, m int
 		return diag.FromErr(err)
 	}
 
+	existingLifecycles.Items, err = getAllPages(ctx, d, client, existingLifecycles)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	flattenedLifecycles := []interface{}{}
 	for _, lifecycle := range existingLifecycles.Items {
 		flattenedLifecycles = append(flattenedLifecycles, flattenLifecycle(lifecycle))
@@ -33,6 +33,11 @@ func dataSourceMachinePoliciesRead(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
+	existingMachinePolicies.Items, err = getAllPages(ctx, d, client, existingMachinePolicies)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	flattenedMachinePolicies := []interface{}{}
 	for _, machinePolicy := range existingMachinePolicies.Items {
 		flattenedMachinePolicies = append(flattenedMachinePolicies, flattenMachinePolicy(machinePolicy))
@@ -0,0 +1,50 @@
+package octopusdeploy
+
+import (
+	"context"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/proxies"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/machineproxies"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceMachineProxies() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides information about existing machine proxies.",
+		ReadContext: dataSourceMachineProxiesRead,
+		Schema:      getMachineProxyDataSchema(),
+	}
+}
+
+func dataSourceMachineProxiesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	query := &proxies.ProxiesQuery{
+		IDs:         expandArray(d.Get("ids").([]interface{})),
+		PartialName: d.Get("partial_name").(string),
+		Skip:        d.Get("skip").(int),
+		Take:        d.Get("take").(int),
+	}
+
+	client := m.(*client.Client)
+	existingProxies, err := machineproxies.Get(client, d.Get("space_id").(string), query)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	existingProxies.Items, err = getAllPages(ctx, d, client, existingProxies)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	flattenedProxies := []interface{}{}
+	for _, proxy := range existingProxies.Items {
+		flattenedProxies = append(flattenedProxies, flattenMachineProxy(proxy))
+	}
+
+	d.Set("machine_proxies", flattenedProxies)
+	d.SetId("MachineProxies " + time.Now().UTC().String())
+
+	return nil
+}
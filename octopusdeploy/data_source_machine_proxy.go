@@ -0,0 +1,42 @@
+package octopusdeploy
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/proxies"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceMachineProxy() *schema.Resource {
+	proxySchema := getMachineProxySchema()
+	delete(proxySchema, "password")
+
+	return &schema.Resource{
+		Description: "Provides information about an existing machine proxy, which is looked up by its exact name or ID.",
+		ReadContext: dataSourceMachineProxyRead,
+		Schema:      getLookupDataSchema("machine proxy", proxySchema),
+	}
+}
+
+func dataSourceMachineProxyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return lookupDataSourceRead(ctx, d, m, "machine proxy", searchMachineProxies)
+}
+
+func searchMachineProxies(ctx context.Context, client *client.Client, spaceID string, ids []string, partialName string) ([]map[string]interface{}, error) {
+	existingProxies, err := getMachineProxies(ctx, client, spaceID, &proxies.ProxiesQuery{
+		IDs:         ids,
+		PartialName: partialName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	flattenedProxies := []map[string]interface{}{}
+	for _, proxy := range existingProxies {
+		flattenedProxies = append(flattenedProxies, flattenMachineProxy(proxy))
+	}
+
+	return flattenedProxies, nil
+}
@@ -0,0 +1,46 @@
+package octopusdeploy
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceProject() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides information about an existing project, which is looked up by its exact name or ID.",
+		ReadContext: dataSourceProjectRead,
+		Schema:      getLookupDataSchema("project", getProjectSchema()),
+	}
+}
+
+func dataSourceProjectRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return lookupDataSourceRead(ctx, d, m, "project", searchProjects)
+}
+
+func searchProjects(ctx context.Context, client *client.Client, spaceID string, ids []string, partialName string) ([]map[string]interface{}, error) {
+	query := projects.ProjectsQuery{
+		IDs:         ids,
+		PartialName: partialName,
+	}
+
+	existingProjects, err := projects.Get(client, spaceID, query)
+	if err != nil {
+		return nil, err
+	}
+
+	nextProjects, err := getNextPages[*projects.Project](ctx, client, existingProjects.PagedResults)
+	if err != nil {
+		return nil, err
+	}
+
+	flattenedProjects := []map[string]interface{}{}
+	for _, project := range append(existingProjects.Items, nextProjects...) {
+		flattenedProjects = append(flattenedProjects, flattenProject(ctx, nil, project))
+	}
+
+	return flattenedProjects, nil
+}
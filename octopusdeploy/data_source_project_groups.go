@@ -34,6 +34,11 @@ func dataSourceProjectGroupsRead(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
+	existingProjectGroups.Items, err = getAllPages(ctx, d, client, existingProjectGroups)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	flattenedProjectGroups := []interface{}{}
 	for _, projectGroup := range existingProjectGroups.Items {
 		flattenedProjectGroups = append(flattenedProjectGroups, flattenProjectGroup(projectGroup))
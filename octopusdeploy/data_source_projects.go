@@ -40,6 +40,11 @@ func dataSourceProjectsRead(ctx context.Context, d *schema.ResourceData, m inter
 		return diag.FromErr(err)
 	}
 
+	existingProjects.Items, err = getAllPages(ctx, d, client, existingProjects)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	flattenedProjects := []interface{}{}
 	for _, project := range existingProjects.Items {
 		flattenedProjects = append(flattenedProjects, flattenProject(ctx, d, project))
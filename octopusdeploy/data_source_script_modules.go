@@ -36,6 +36,11 @@ func dataSourceScriptModulesRead(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
+	existingScriptModules.Items, err = getAllPages(ctx, d, client, existingScriptModules)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	flattenedScriptModules := []interface{}{}
 	for _, scriptModule := range existingScriptModules.Items {
 		flattenedScriptModules = append(flattenedScriptModules, flattenScriptModule(scriptModule))
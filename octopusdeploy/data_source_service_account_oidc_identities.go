@@ -0,0 +1,42 @@
+package octopusdeploy
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/serviceaccounts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceServiceAccountOIDCIdentities() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides information about the OIDC identities of a service account, including the external ID that CI systems use as the audience of OIDC tokens.",
+		ReadContext: dataSourceServiceAccountOIDCIdentitiesRead,
+		Schema:      getServiceAccountOIDCIdentitiesDataSchema(),
+	}
+}
+
+func dataSourceServiceAccountOIDCIdentitiesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	serviceAccountID := d.Get("service_account_id").(string)
+
+	client := m.(*client.Client)
+	identities, err := serviceaccounts.GetAll(client, serviceAccountID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	flattenedIdentities := []interface{}{}
+	for _, identity := range identities.OIDCIdentities {
+		if len(identity.ServiceAccountID) == 0 {
+			identity.ServiceAccountID = serviceAccountID
+		}
+		flattenedIdentities = append(flattenedIdentities, flattenServiceAccountOIDCIdentity(identity, identities.ExternalID))
+	}
+
+	d.Set("external_id", identities.ExternalID)
+	d.Set("oidc_identities", flattenedIdentities)
+	d.SetId("ServiceAccountOIDCIdentities " + serviceAccountID)
+
+	return nil
+}
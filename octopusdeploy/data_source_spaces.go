@@ -36,6 +36,11 @@ func dataSourceSpacesRead(ctx context.Context, d *schema.ResourceData, m interfa
 		return diag.FromErr(err)
 	}
 
+	existingSpaces.Items, err = getAllPages(ctx, d, client, existingSpaces)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	for _, space := range existingSpaces.Items {
 		flattenedSpaces = append(flattenedSpaces, flattenSpace(space))
 	}
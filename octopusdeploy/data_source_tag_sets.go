@@ -33,6 +33,11 @@ func dataSourceTagSetsRead(ctx context.Context, d *schema.ResourceData, m interf
 		return diag.FromErr(err)
 	}
 
+	existingTagSets.Items, err = getAllPages(ctx, d, octopus, existingTagSets)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	flattenedTagSets := []interface{}{}
 	
 	for _, tagSet := range existingTagSets.Items {
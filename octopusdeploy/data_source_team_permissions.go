@@ -0,0 +1,36 @@
+package octopusdeploy
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/userroles"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTeamPermissions() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides the effective permissions of a team, resolved from the permissions granted by the user roles of its scoped user roles and the environments, projects, project groups and tenants that they are restricted to.",
+		ReadContext: dataSourceTeamPermissionsRead,
+		Schema:      getTeamPermissionsDataSchema(),
+	}
+}
+
+func dataSourceTeamPermissionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	teamID := d.Get("team_id").(string)
+
+	client := m.(*client.Client)
+	spacePermissions, systemPermissions, err := getTeamEffectivePermissions(client, teamID, map[string]*userroles.UserRole{}, expandEffectivePermissionsFilter(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sortEffectivePermissions(spacePermissions)
+
+	d.Set("space_permissions", flattenEffectivePermissions(spacePermissions))
+	d.Set("system_permissions", uniqueSortedStrings(systemPermissions))
+	d.SetId("TeamPermissions " + teamID)
+
+	return nil
+}
@@ -34,6 +34,11 @@ func dataSourceTeamsRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return diag.FromErr(err)
 	}
 
+	existingTeams.Items, err = getAllPages(ctx, d, client, existingTeams)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	flattenedTeams := []interface{}{}
 	for _, team := range existingTeams.Items {
 		flattenedTeams = append(flattenedTeams, flattenTeam(team))
@@ -0,0 +1,46 @@
+package octopusdeploy
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tenants"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceTenant() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides information about an existing tenant, which is looked up by its exact name or ID.",
+		ReadContext: dataSourceTenantRead,
+		Schema:      getLookupDataSchema("tenant", getTenantSchema()),
+	}
+}
+
+func dataSourceTenantRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return lookupDataSourceRead(ctx, d, m, "tenant", searchTenants)
+}
+
+func searchTenants(ctx context.Context, client *client.Client, spaceID string, ids []string, partialName string) ([]map[string]interface{}, error) {
+	query := tenants.TenantsQuery{
+		IDs:         ids,
+		PartialName: partialName,
+	}
+
+	existingTenants, err := tenants.Get(client, spaceID, query)
+	if err != nil {
+		return nil, err
+	}
+
+	nextTenants, err := getNextPages[*tenants.Tenant](ctx, client, existingTenants.PagedResults)
+	if err != nil {
+		return nil, err
+	}
+
+	flattenedTenants := []map[string]interface{}{}
+	for _, tenant := range append(existingTenants.Items, nextTenants...) {
+		flattenedTenants = append(flattenedTenants, flattenTenant(tenant))
+	}
+
+	return flattenedTenants, nil
+}
@@ -37,6 +37,11 @@ func dataSourceTenantsRead(ctx context.Context, d *schema.ResourceData, meta int
 		return diag.FromErr(err)
 	}
 
+	existingTenants.Items, err = getAllPages(ctx, d, client, existingTenants)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	flattenedTenants := []interface{}{}
 	for _, tenant := range existingTenants.Items {
 		flattenedTenants = append(flattenedTenants, flattenTenant(tenant))
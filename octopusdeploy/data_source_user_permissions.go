@@ -0,0 +1,59 @@
+package octopusdeploy
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/userroles"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceUserPermissions() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides the effective permissions of a user, resolved from the scoped user roles of every team that the user is a member of (including teams such as `Everyone` and teams joined through external security groups).",
+		ReadContext: dataSourceUserPermissionsRead,
+		Schema:      getUserPermissionsDataSchema(),
+	}
+}
+
+func dataSourceUserPermissionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	userID := d.Get("user_id").(string)
+
+	client := m.(*client.Client)
+	user, err := client.Users.GetByID(userID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	userTeams, err := client.Users.GetTeams(user)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filter := expandEffectivePermissionsFilter(d)
+	userRoles := map[string]*userroles.UserRole{}
+
+	spacePermissions := []effectivePermission{}
+	systemPermissions := []string{}
+	teamIDs := []string{}
+	for _, team := range *userTeams {
+		teamSpacePermissions, teamSystemPermissions, err := getTeamEffectivePermissions(client, team.ID, userRoles, filter)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		spacePermissions = append(spacePermissions, teamSpacePermissions...)
+		systemPermissions = append(systemPermissions, teamSystemPermissions...)
+		teamIDs = append(teamIDs, team.ID)
+	}
+
+	sortEffectivePermissions(spacePermissions)
+
+	d.Set("space_permissions", flattenEffectivePermissions(spacePermissions))
+	d.Set("system_permissions", uniqueSortedStrings(systemPermissions))
+	d.Set("team_ids", uniqueSortedStrings(teamIDs))
+	d.SetId("UserPermissions " + userID)
+
+	return nil
+}
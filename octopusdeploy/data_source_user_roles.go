@@ -34,6 +34,11 @@ func dataSourceUserRolesRead(ctx context.Context, d *schema.ResourceData, meta i
 		return diag.FromErr(err)
 	}
 
+	existingUserRoles.Items, err = getAllPages(ctx, d, client, existingUserRoles)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	flattenedUserRoles := []interface{}{}
 	for _, userRole := range existingUserRoles.Items {
 		flattenedUserRoles = append(flattenedUserRoles, flattenUserRole(userRole))
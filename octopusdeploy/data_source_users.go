@@ -34,6 +34,11 @@ func dataSourceUsersRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return diag.FromErr(err)
 	}
 
+	existingUsers.Items, err = getAllPages(ctx, d, client, existingUsers)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	flattenedUsers := []interface{}{}
 	for _, user := range existingUsers.Items {
 		flattenedUsers = append(flattenedUsers, flattenUser(user))
@@ -0,0 +1,55 @@
+package octopusdeploy
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/workerpools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceWorkerPool() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides information about an existing worker pool, which is looked up by its exact name or ID.",
+		ReadContext: dataSourceWorkerPoolRead,
+		Schema:      getLookupDataSchema("worker pool", getWorkerPoolSchema()),
+	}
+}
+
+func dataSourceWorkerPoolRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return lookupDataSourceRead(ctx, d, m, "worker pool", searchWorkerPools)
+}
+
+func searchWorkerPools(ctx context.Context, client *client.Client, spaceID string, ids []string, partialName string) ([]map[string]interface{}, error) {
+	query := workerpools.WorkerPoolsQuery{
+		IDs:         ids,
+		PartialName: partialName,
+	}
+
+	existingWorkerPools, err := workerpools.Get(client, spaceID, query)
+	if err != nil {
+		return nil, err
+	}
+
+	flattenedWorkerPools := []map[string]interface{}{}
+	for _, workerPool := range existingWorkerPools.Items {
+		workerPoolResource, err := workerpools.ToWorkerPoolResource(workerPool)
+		if err != nil {
+			return nil, err
+		}
+
+		flattenedWorkerPools = append(flattenedWorkerPools, flattenWorkerPool(workerPoolResource))
+	}
+
+	nextWorkerPools, err := getNextPages[*workerpools.WorkerPoolResource](ctx, client, existingWorkerPools.PagedResults)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, workerPoolResource := range nextWorkerPools {
+		flattenedWorkerPools = append(flattenedWorkerPools, flattenWorkerPool(workerPoolResource))
+	}
+
+	return flattenedWorkerPools, nil
+}
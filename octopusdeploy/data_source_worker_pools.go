@@ -42,6 +42,17 @@ func dataSourceWorkerPoolsRead(ctx context.Context, d *schema.ResourceData, m in
 		flattenedWorkerPools = append(flattenedWorkerPools, flattenWorkerPool(workerPoolResource))
 	}
 
+	if isAllPagesQuery(d) {
+		nextWorkerPools, err := getNextPages[*workerpools.WorkerPoolResource](ctx, client, workerPools.PagedResults)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, workerPoolResource := range nextWorkerPools {
+			flattenedWorkerPools = append(flattenedWorkerPools, flattenWorkerPool(workerPoolResource))
+		}
+	}
+
 	d.Set("worker_pools", flattenedWorkerPools)
 	d.SetId("Worker Pools " + time.Now().UTC().String())
 
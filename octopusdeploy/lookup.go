@@ -0,0 +1,227 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// lookupMaxSuggestions is the maximum number of near-miss names that are listed when a lookup fails.
+const lookupMaxSuggestions = 5
+
+// lookupSearchFunc returns the flattened objects of a space that have one of the input IDs or, when no IDs are
+// specified, whose names contain the input partial name. Every page of the collection is returned; an empty partial
+// name returns every object of the space.
+type lookupSearchFunc func(ctx context.Context, client *client.Client, spaceID string, ids []string, partialName string) ([]map[string]interface{}, error)
+
+// getLookupDataSchema returns the schema of a data source that looks up a single object by its exact name or ID. The
+// input schema is the schema of the object, which becomes computed.
+func getLookupDataSchema(objectName string, objectSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	setDataSchema(&objectSchema)
+
+	objectSchema["id"] = &schema.Schema{
+		Computed:     true,
+		Description:  fmt.Sprintf("The ID of the %s to look up. Exactly one of `id` or `name` must be specified.", objectName),
+		ExactlyOneOf: []string{"id", "name"},
+		Optional:     true,
+		Type:         schema.TypeString,
+	}
+	objectSchema["name"] = &schema.Schema{
+		Computed:     true,
+		Description:  fmt.Sprintf("The exact name of the %s to look up. Exactly one of `id` or `name` must be specified.", objectName),
+		ExactlyOneOf: []string{"id", "name"},
+		Optional:     true,
+		Type:         schema.TypeString,
+	}
+	objectSchema["space_id"] = &schema.Schema{
+		Computed:    true,
+		Description: fmt.Sprintf("The space ID of the %s. Will revert what is specified on the provider if not set.", objectName),
+		Optional:    true,
+		Type:        schema.TypeString,
+	}
+
+	return objectSchema
+}
+
+// lookupDataSourceRead finds the single object that has the ID or the exact name of the data source and sets its
+// attributes. When no object (or more than one object) matches, the diagnostic lists the names that come closest.
+func lookupDataSourceRead(ctx context.Context, d *schema.ResourceData, m interface{}, objectName string, search lookupSearchFunc) diag.Diagnostics {
+	client := m.(*client.Client)
+	spaceID := d.Get("space_id").(string)
+
+	id := d.Get("id").(string)
+	name := d.Get("name").(string)
+
+	var matches []map[string]interface{}
+	if len(id) > 0 {
+		objects, err := search(ctx, client, spaceID, []string{id}, "")
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		matches = filterLookupObjects(objects, "id", id)
+		if len(matches) == 0 {
+			return diag.Errorf("no %s with ID '%s' was found", objectName, id)
+		}
+	} else {
+		objects, err := search(ctx, client, spaceID, nil, name)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		matches = filterLookupObjects(objects, "name", name)
+		if len(matches) == 0 {
+			allObjects, err := search(ctx, client, spaceID, nil, "")
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			return diag.Diagnostics{getLookupNotFoundDiagnostic(objectName, name, allObjects)}
+		}
+	}
+
+	if len(matches) > 1 {
+		return diag.Diagnostics{getLookupAmbiguousDiagnostic(objectName, matches)}
+	}
+
+	log.Printf("[INFO] found %s (%s)", objectName, matches[0]["id"])
+
+	for key, value := range matches[0] {
+		if key == "id" || value == nil {
+			continue
+		}
+
+		if err := d.Set(key, value); err != nil {
+			return diag.Errorf("error setting %s: %s", key, err)
+		}
+	}
+
+	d.SetId(matches[0]["id"].(string))
+
+	return nil
+}
+
+func filterLookupObjects(objects []map[string]interface{}, key string, value string) []map[string]interface{} {
+	matches := []map[string]interface{}{}
+	for _, object := range objects {
+		if v, ok := object[key].(string); ok && v == value {
+			matches = append(matches, object)
+		}
+	}
+
+	return matches
+}
+
+func getLookupNotFoundDiagnostic(objectName string, name string, objects []map[string]interface{}) diag.Diagnostic {
+	detail := fmt.Sprintf("There is no %s with this exact name (names are case-sensitive).", objectName)
+	if suggestions := getLookupSuggestions(name, objects); len(suggestions) > 0 {
+		detail += fmt.Sprintf(" Did you mean %s?", strings.Join(suggestions, ", "))
+	}
+
+	return diag.Diagnostic{
+		Severity:      diag.Error,
+		Summary:       fmt.Sprintf("no %s named %q was found", objectName, name),
+		Detail:        detail,
+		AttributePath: cty.GetAttrPath("name"),
+	}
+}
+
+func getLookupAmbiguousDiagnostic(objectName string, matches []map[string]interface{}) diag.Diagnostic {
+	descriptions := []string{}
+	for _, match := range matches {
+		descriptions = append(descriptions, fmt.Sprintf("%q (%s)", match["name"], match["id"]))
+	}
+
+	return diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("%d %ss match the lookup", len(matches), objectName),
+		Detail:   fmt.Sprintf("The lookup must match a single %s, but it matches %s. Look it up by its ID instead.", objectName, strings.Join(descriptions, ", ")),
+	}
+}
+
+// getLookupSuggestions returns the quoted names of the objects that come closest to the input name: the names that
+// contain it (or are contained by it) regardless of case and the names that are only a few edits away from it.
+func getLookupSuggestions(name string, objects []map[string]interface{}) []string {
+	type suggestion struct {
+		distance int
+		name     string
+	}
+
+	lowerName := strings.ToLower(name)
+	maxDistance := len(lowerName) / 3
+	if maxDistance < 2 {
+		maxDistance = 2
+	}
+
+	suggestions := []suggestion{}
+	seen := map[string]bool{}
+	for _, object := range objects {
+		candidate, ok := object["name"].(string)
+		if !ok || len(candidate) == 0 || seen[candidate] {
+			continue
+		}
+
+		lowerCandidate := strings.ToLower(candidate)
+		distance := getEditDistance(lowerName, lowerCandidate)
+		if distance > maxDistance && !strings.Contains(lowerCandidate, lowerName) && !strings.Contains(lowerName, lowerCandidate) {
+			continue
+		}
+
+		seen[candidate] = true
+		suggestions = append(suggestions, suggestion{distance: distance, name: candidate})
+	}
+
+	sort.Slice(suggestions, func(i, j int) bool {
+		if suggestions[i].distance != suggestions[j].distance {
+			return suggestions[i].distance < suggestions[j].distance
+		}
+		return suggestions[i].name < suggestions[j].name
+	})
+
+	names := []string{}
+	for i := 0; i < len(suggestions) && i < lookupMaxSuggestions; i++ {
+		names = append(names, fmt.Sprintf("%q", suggestions[i].name))
+	}
+
+	return names
+}
+
+// getEditDistance returns the Levenshtein distance between two strings.
+func getEditDistance(a string, b string) int {
+	source := []rune(a)
+	target := []rune(b)
+
+	previous := make([]int, len(target)+1)
+	current := make([]int, len(target)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(source); i++ {
+		current[0] = i
+		for j := 1; j <= len(target); j++ {
+			cost := 1
+			if source[i-1] == target[j-1] {
+				cost = 0
+			}
+
+			current[j] = previous[j-1] + cost
+			if previous[j]+1 < current[j] {
+				current[j] = previous[j] + 1
+			}
+			if current[j-1]+1 < current[j] {
+				current[j] = current[j-1] + 1
+			}
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(target)]
+}
@@ -0,0 +1,89 @@
+package octopusdeploy
+
+import (
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/environments"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func getTestLookupObjects(names ...string) []map[string]interface{} {
+	objects := []map[string]interface{}{}
+	for i, name := range names {
+		objects = append(objects, map[string]interface{}{
+			"id":   "Environments-" + string(rune('1'+i)),
+			"name": name,
+		})
+	}
+
+	return objects
+}
+
+func TestGetEditDistance(t *testing.T) {
+	require.Equal(t, 0, getEditDistance("production", "production"))
+	require.Equal(t, 1, getEditDistance("production", "prodution"))
+	require.Equal(t, 2, getEditDistance("staging", "stagnig"))
+	require.Equal(t, 4, getEditDistance("", "test"))
+	require.Equal(t, 3, getEditDistance("kitten", "sitting"))
+}
+
+func TestGetLookupSuggestions(t *testing.T) {
+	objects := getTestLookupObjects("Production", "Production EU", "Staging", "Development", "production")
+
+	require.Equal(t, []string{`"Production"`, `"production"`}, getLookupSuggestions("Prodution", objects))
+	require.Equal(t, []string{`"Production"`, `"production"`, `"Production EU"`}, getLookupSuggestions("Prod", objects))
+	require.Equal(t, []string{`"Staging"`}, getLookupSuggestions("staging", objects))
+	require.Empty(t, getLookupSuggestions("Test", objects))
+}
+
+func TestGetLookupSuggestionsLimit(t *testing.T) {
+	objects := getTestLookupObjects("Test 1", "Test 2", "Test 3", "Test 4", "Test 5", "Test 6", "Test 7")
+
+	require.Len(t, getLookupSuggestions("Test", objects), lookupMaxSuggestions)
+}
+
+func TestFilterLookupObjects(t *testing.T) {
+	objects := getTestLookupObjects("Production", "production", "Staging")
+
+	matches := filterLookupObjects(objects, "name", "Production")
+	require.Len(t, matches, 1)
+	require.Equal(t, "Environments-1", matches[0]["id"])
+
+	require.Empty(t, filterLookupObjects(objects, "name", "Prod"))
+	require.Len(t, filterLookupObjects(objects, "id", "Environments-3"), 1)
+}
+
+func TestGetLookupNotFoundDiagnostic(t *testing.T) {
+	diagnostic := getLookupNotFoundDiagnostic("environment", "Prodution", getTestLookupObjects("Production", "Staging"))
+	require.Equal(t, diag.Error, diagnostic.Severity)
+	require.Equal(t, `no environment named "Prodution" was found`, diagnostic.Summary)
+	require.Contains(t, diagnostic.Detail, `Did you mean "Production"?`)
+
+	diagnostic = getLookupNotFoundDiagnostic("environment", "Test", getTestLookupObjects("Production", "Staging"))
+	require.NotContains(t, diagnostic.Detail, "Did you mean")
+}
+
+func TestGetLookupAmbiguousDiagnostic(t *testing.T) {
+	diagnostic := getLookupAmbiguousDiagnostic("environment", getTestLookupObjects("Production", "Production"))
+	require.Equal(t, "2 environments match the lookup", diagnostic.Summary)
+	require.Contains(t, diagnostic.Detail, `"Production" (Environments-1), "Production" (Environments-2)`)
+}
+
+func TestGetLookupDataSchema(t *testing.T) {
+	lookupSchema := getLookupDataSchema("environment", getEnvironmentSchema())
+	require.True(t, lookupSchema["id"].Optional)
+	require.True(t, lookupSchema["name"].Optional)
+	require.Equal(t, []string{"id", "name"}, lookupSchema["name"].ExactlyOneOf)
+	require.True(t, lookupSchema["space_id"].Optional)
+	require.False(t, lookupSchema["description"].Optional)
+	require.True(t, lookupSchema["description"].Computed)
+
+	d := schema.TestResourceDataRaw(t, lookupSchema, map[string]interface{}{})
+	environment := environments.NewEnvironment("Production")
+	environment.ID = "Environments-1"
+	for key, value := range flattenEnvironment(environment) {
+		require.NoError(t, d.Set(key, value))
+	}
+}
@@ -0,0 +1,100 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/machines"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/servertasks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// waitForDeploymentTargetHealth waits for a deployment target to become healthy when its resource has a
+// wait_for_health block.
+func waitForDeploymentTargetHealth(ctx context.Context, d *schema.ResourceData, client *client.Client) diag.Diagnostics {
+	wait := expandWaitForHealth(d.Get("wait_for_health"))
+	if wait == nil {
+		return nil
+	}
+
+	getHealthStatus := func() (string, error) {
+		deploymentTarget, err := machines.GetByID(client, d.Get("space_id").(string), d.Id())
+		if err != nil {
+			return "", err
+		}
+		return deploymentTarget.HealthStatus, nil
+	}
+
+	return waitForMachineHealth(ctx, client, d.Get("space_id").(string), d.Id(), d.Get("name").(string), wait, getHealthStatus)
+}
+
+// waitForMachineHealth queues health checks of a machine until it reports one of the required health statuses or the
+// timeout elapses. A new health check is queued whenever the previous one completes without the machine reaching a
+// required status, so that machines which are still starting (i.e. polling tentacles) have time to connect.
+func waitForMachineHealth(ctx context.Context, client *client.Client, spaceID string, machineID string, machineName string, wait *waitForHealth, getHealthStatus func() (string, error)) diag.Diagnostics {
+	deadline := time.Now().Add(wait.Timeout)
+	healthCheckTaskID := ""
+	isQueued := false
+
+	err := resource.RetryContext(ctx, wait.Timeout, func() *resource.RetryError {
+		if !isQueued {
+			description := fmt.Sprintf("Check health of %s", machineName)
+			task, err := servertasks.Add(client, spaceID, servertasks.NewHealthCheckTask(spaceID, description, []string{machineID}, time.Until(deadline)))
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+
+			healthCheckTaskID = task.GetID()
+			isQueued = true
+
+			log.Printf("[INFO] queued health check (%s) of machine (%s)", healthCheckTaskID, machineID)
+			return resource.RetryableError(fmt.Errorf("health check %s of %s is queued", healthCheckTaskID, machineName))
+		}
+
+		task, err := servertasks.GetByID(client, spaceID, healthCheckTaskID)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if !servertasks.IsCompleted(task) {
+			return resource.RetryableError(fmt.Errorf("health check %s of %s is %s", healthCheckTaskID, machineName, task.State))
+		}
+
+		healthStatus, err := getHealthStatus()
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		for _, requiredStatus := range wait.RequiredStatuses {
+			if healthStatus == requiredStatus {
+				log.Printf("[INFO] machine (%s) is %s", machineID, healthStatus)
+				return nil
+			}
+		}
+
+		isQueued = false
+		return resource.RetryableError(fmt.Errorf("%s is %s after health check %s (%s); required: %s", machineName, healthStatus, healthCheckTaskID, task.State, strings.Join(wait.RequiredStatuses, ", ")))
+	})
+	if err == nil {
+		return nil
+	}
+
+	detail := err.Error()
+	if len(healthCheckTaskID) > 0 {
+		if taskLog := getServerTaskLog(client, spaceID, healthCheckTaskID); len(taskLog) > 0 {
+			detail += fmt.Sprintf("\n\nLog of health check %s:\n\n%s", healthCheckTaskID, taskLog)
+		}
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("%s did not become %s", machineName, strings.Join(wait.RequiredStatuses, " or ")),
+		Detail:   detail,
+	}}
+}
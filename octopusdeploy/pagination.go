@@ -0,0 +1,66 @@
+package octopusdeploy
+
+import (
+	"context"
+	"log"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// isAllPagesQuery reports whether a list data source returns every page of the collection that matches its filter(s)
+// rather than the single page that is defined by skip and take. Unless all_pages is set, every page is returned when
+// take is not set.
+func isAllPagesQuery(d *schema.ResourceData) bool {
+	if config := d.GetRawConfig(); !config.IsNull() && config.IsKnown() && config.Type().HasAttribute("all_pages") {
+		if v := config.GetAttr("all_pages"); !v.IsNull() && v.IsKnown() {
+			return v.True()
+		}
+	}
+
+	return d.Get("all_pages").(bool) || d.Get("take").(int) == 0
+}
+
+// getAllPages returns the items of the first page of a collection and, when all pages are queried, the items of the
+// pages that follow it.
+func getAllPages[T any](ctx context.Context, d *schema.ResourceData, client newclient.Client, page *resources.Resources[T]) ([]T, error) {
+	if !isAllPagesQuery(d) {
+		return page.Items, nil
+	}
+
+	nextItems, err := getNextPages[T](ctx, client, page.PagedResults)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(page.Items, nextItems...), nil
+}
+
+// getNextPages follows the Page.Next links of a collection and returns the items of the pages that follow the one that
+// the input paged results belong to. Collections that convert their items (i.e. feeds and worker pools) use it with
+// the resource type that the server returns.
+func getNextPages[T any](ctx context.Context, client newclient.Client, pagedResults resources.PagedResults) ([]T, error) {
+	items := []T{}
+	for next := pagedResults.Links.PageNext; len(next) > 0; {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		log.Printf("[DEBUG] reading next page (%s)", next)
+
+		page, err := newclient.Get[resources.Resources[T]](client.HttpSession(), next)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, page.Items...)
+
+		if page.Links.PageNext == next {
+			break
+		}
+		next = page.Links.PageNext
+	}
+
+	return items, nil
+}
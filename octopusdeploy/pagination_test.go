@@ -0,0 +1,130 @@
+package octopusdeploy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/environments"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+const testPageSize = 3
+
+// newPaginatedEnvironmentsServer serves a collection of environments in pages in the same way as Octopus Deploy: the
+// page size defaults to testPageSize and every page except the last one links to the next one.
+func newPaginatedEnvironmentsServer(t *testing.T, count int) (newclient.Client, *int) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/Spaces-1/environments", r.URL.Path)
+		requests++
+
+		skip, _ := strconv.Atoi(r.URL.Query().Get("skip"))
+		take, err := strconv.Atoi(r.URL.Query().Get("take"))
+		if err != nil {
+			take = testPageSize
+		}
+
+		page := resources.Resources[*environments.Environment]{Items: []*environments.Environment{}}
+		page.ItemsPerPage = take
+		page.TotalResults = count
+		for i := skip; i < count && i < skip+take; i++ {
+			environment := environments.NewEnvironment(fmt.Sprintf("Environment %d", i+1))
+			environment.ID = fmt.Sprintf("Environments-%d", i+1)
+			page.Items = append(page.Items, environment)
+		}
+		if skip+take < count {
+			page.Links.PageNext = fmt.Sprintf("/api/Spaces-1/environments?skip=%d&take=%d", skip+take, take)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(page))
+	}))
+	t.Cleanup(server.Close)
+
+	baseURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	client := newclient.NewClientS(&newclient.HttpSession{
+		BaseURL:    baseURL,
+		HttpClient: server.Client(),
+	}, "Spaces-1")
+
+	return client, &requests
+}
+
+func getTestEnvironments(t *testing.T, client newclient.Client, raw map[string]interface{}) []*environments.Environment {
+	d := schema.TestResourceDataRaw(t, getEnvironmentDataSchema(), raw)
+
+	query := environments.EnvironmentsQuery{
+		Skip: d.Get("skip").(int),
+		Take: d.Get("take").(int),
+	}
+
+	page, err := environments.Get(client, "Spaces-1", query)
+	require.NoError(t, err)
+
+	items, err := getAllPages(context.Background(), d, client, page)
+	require.NoError(t, err)
+
+	return items
+}
+
+func TestGetAllPagesWithoutTake(t *testing.T) {
+	client, requests := newPaginatedEnvironmentsServer(t, 8)
+
+	items := getTestEnvironments(t, client, map[string]interface{}{})
+	require.Len(t, items, 8)
+	require.Equal(t, "Environments-1", items[0].ID)
+	require.Equal(t, "Environments-8", items[7].ID)
+	require.Equal(t, 3, *requests)
+}
+
+func TestGetAllPagesWithTake(t *testing.T) {
+	client, requests := newPaginatedEnvironmentsServer(t, 8)
+
+	items := getTestEnvironments(t, client, map[string]interface{}{"skip": 2, "take": 2})
+	require.Len(t, items, 2)
+	require.Equal(t, "Environments-3", items[0].ID)
+	require.Equal(t, 1, *requests)
+}
+
+func TestGetAllPagesWithTakeAndAllPages(t *testing.T) {
+	client, requests := newPaginatedEnvironmentsServer(t, 8)
+
+	items := getTestEnvironments(t, client, map[string]interface{}{"all_pages": true, "skip": 1, "take": 4})
+	require.Len(t, items, 7)
+	require.Equal(t, "Environments-2", items[0].ID)
+	require.Equal(t, "Environments-8", items[6].ID)
+	require.Equal(t, 2, *requests)
+}
+
+func TestGetAllPagesSinglePage(t *testing.T) {
+	client, requests := newPaginatedEnvironmentsServer(t, 2)
+
+	items := getTestEnvironments(t, client, map[string]interface{}{})
+	require.Len(t, items, 2)
+	require.Equal(t, 1, *requests)
+}
+
+func TestGetNextPagesStopsWhenCanceled(t *testing.T) {
+	client, requests := newPaginatedEnvironmentsServer(t, 8)
+
+	page, err := environments.Get(client, "Spaces-1", environments.EnvironmentsQuery{})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = getNextPages[*environments.Environment](ctx, client, page.PagedResults)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, 1, *requests)
+}
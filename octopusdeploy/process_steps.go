@@ -0,0 +1,194 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/runbookprocess"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// process wraps either a deployment process or a runbook process so that the
+// steps within it can be read and modified independently of the owning resource.
+type process struct {
+	deploymentProcess *deployments.DeploymentProcess
+	runbookProcess    *runbookprocess.RunbookProcess
+}
+
+func isRunbookProcessID(processID string) bool {
+	return strings.HasPrefix(strings.ToLower(processID), "runbookprocess-")
+}
+
+func getProcess(client *client.Client, spaceID string, processID string) (*process, error) {
+	if isRunbookProcessID(processID) {
+		runbookProcess, err := runbookprocess.GetByID(client, spaceID, processID)
+		if err != nil {
+			return nil, err
+		}
+		return &process{runbookProcess: runbookProcess}, nil
+	}
+
+	deploymentProcess, err := client.DeploymentProcesses.GetByID(processID)
+	if err != nil {
+		return nil, err
+	}
+	return &process{deploymentProcess: deploymentProcess}, nil
+}
+
+func (p *process) getSteps() []*deployments.DeploymentStep {
+	if p.runbookProcess != nil {
+		return p.runbookProcess.Steps
+	}
+	return p.deploymentProcess.Steps
+}
+
+func (p *process) setSteps(steps []*deployments.DeploymentStep) {
+	if p.runbookProcess != nil {
+		p.runbookProcess.Steps = steps
+		return
+	}
+	p.deploymentProcess.Steps = steps
+}
+
+func (p *process) getVersion() int32 {
+	if p.runbookProcess != nil {
+		if p.runbookProcess.Version == nil {
+			return 0
+		}
+		return *p.runbookProcess.Version
+	}
+	return p.deploymentProcess.Version
+}
+
+func (p *process) update(client *client.Client) (*process, error) {
+	if p.runbookProcess != nil {
+		updatedRunbookProcess, err := runbookprocess.Update(client, p.runbookProcess)
+		if err != nil {
+			return nil, err
+		}
+		return &process{runbookProcess: updatedRunbookProcess}, nil
+	}
+
+	updatedDeploymentProcess, err := client.DeploymentProcesses.Update(p.deploymentProcess)
+	if err != nil {
+		return nil, err
+	}
+	return &process{deploymentProcess: updatedDeploymentProcess}, nil
+}
+
+// updateProcessSteps performs a read-modify-write of the steps within a process. The version of the process
+// read is sent with the update; if the update fails and the process has since been modified by someone else,
+// the whole operation is retried against the latest version until the timeout elapses.
+func updateProcessSteps(ctx context.Context, client *client.Client, spaceID string, processID string, timeout time.Duration, modify func([]*deployments.DeploymentStep) ([]*deployments.DeploymentStep, error)) (*process, error) {
+	var updatedProcess *process
+
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		current, err := getProcess(client, spaceID, processID)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		steps, err := modify(current.getSteps())
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		current.setSteps(steps)
+
+		updatedProcess, err = current.update(client)
+		if err == nil {
+			return nil
+		}
+
+		latest, getErr := getProcess(client, spaceID, processID)
+		if getErr == nil && latest.getVersion() != current.getVersion() {
+			log.Printf("[INFO] process (%s) was modified from version %d to %d during update; retrying", processID, current.getVersion(), latest.getVersion())
+			return resource.RetryableError(err)
+		}
+
+		return resource.NonRetryableError(err)
+	})
+
+	return updatedProcess, err
+}
+
+func findProcessStep(steps []*deployments.DeploymentStep, stepID string, name string) *deployments.DeploymentStep {
+	for _, step := range steps {
+		if len(stepID) > 0 && step.ID == stepID {
+			return step
+		}
+	}
+
+	if len(name) > 0 {
+		for _, step := range steps {
+			if step.Name == name {
+				return step
+			}
+		}
+	}
+
+	return nil
+}
+
+func addProcessStep(steps []*deployments.DeploymentStep, step *deployments.DeploymentStep) ([]*deployments.DeploymentStep, error) {
+	if existing := findProcessStep(steps, "", step.Name); existing != nil {
+		return nil, fmt.Errorf("a step named '%s' already exists in this process (%s); import it instead of creating it", step.Name, existing.ID)
+	}
+
+	return append(steps, step), nil
+}
+
+func replaceProcessStep(steps []*deployments.DeploymentStep, stepID string, step *deployments.DeploymentStep) ([]*deployments.DeploymentStep, error) {
+	for i := range steps {
+		if steps[i].ID == stepID {
+			step.ID = stepID
+			steps[i] = step
+			return steps, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unable to find step (%s) in process", stepID)
+}
+
+func removeProcessStep(steps []*deployments.DeploymentStep, stepID string) []*deployments.DeploymentStep {
+	remainingSteps := []*deployments.DeploymentStep{}
+	for _, step := range steps {
+		if step.ID != stepID {
+			remainingSteps = append(remainingSteps, step)
+		}
+	}
+	return remainingSteps
+}
+
+// reorderProcessSteps moves the steps identified by stepIDs to the start of the process in the order given.
+// Steps that are not listed retain their relative order after the listed steps.
+func reorderProcessSteps(steps []*deployments.DeploymentStep, stepIDs []string) ([]*deployments.DeploymentStep, error) {
+	orderedSteps := []*deployments.DeploymentStep{}
+	listed := map[string]bool{}
+
+	for _, stepID := range stepIDs {
+		if listed[stepID] {
+			return nil, fmt.Errorf("step (%s) is listed more than once", stepID)
+		}
+
+		step := findProcessStep(steps, stepID, "")
+		if step == nil {
+			return nil, fmt.Errorf("unable to find step (%s) in process", stepID)
+		}
+
+		listed[stepID] = true
+		orderedSteps = append(orderedSteps, step)
+	}
+
+	for _, step := range steps {
+		if !listed[step.ID] {
+			orderedSteps = append(orderedSteps, step)
+		}
+	}
+
+	return orderedSteps, nil
+}
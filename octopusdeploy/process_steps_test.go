@@ -0,0 +1,78 @@
+package octopusdeploy
+
+import (
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestProcessSteps(names ...string) []*deployments.DeploymentStep {
+	steps := []*deployments.DeploymentStep{}
+	for _, name := range names {
+		step := deployments.NewDeploymentStep(name)
+		step.ID = "id-" + name
+		steps = append(steps, step)
+	}
+	return steps
+}
+
+func getStepNames(steps []*deployments.DeploymentStep) []string {
+	names := []string{}
+	for _, step := range steps {
+		names = append(names, step.Name)
+	}
+	return names
+}
+
+func TestAddProcessStep(t *testing.T) {
+	steps, err := addProcessStep(newTestProcessSteps("a", "b"), deployments.NewDeploymentStep("c"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b", "c"}, getStepNames(steps))
+
+	_, err = addProcessStep(newTestProcessSteps("a", "b"), deployments.NewDeploymentStep("b"))
+	require.Error(t, err)
+}
+
+func TestReplaceProcessStep(t *testing.T) {
+	replacement := deployments.NewDeploymentStep("renamed")
+	steps, err := replaceProcessStep(newTestProcessSteps("a", "b", "c"), "id-b", replacement)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "renamed", "c"}, getStepNames(steps))
+	require.Equal(t, "id-b", steps[1].ID)
+
+	_, err = replaceProcessStep(newTestProcessSteps("a"), "id-missing", replacement)
+	require.Error(t, err)
+}
+
+func TestRemoveProcessStep(t *testing.T) {
+	steps := removeProcessStep(newTestProcessSteps("a", "b", "c"), "id-b")
+	require.Equal(t, []string{"a", "c"}, getStepNames(steps))
+
+	steps = removeProcessStep(newTestProcessSteps("a"), "id-missing")
+	require.Equal(t, []string{"a"}, getStepNames(steps))
+}
+
+func TestReorderProcessSteps(t *testing.T) {
+	steps, err := reorderProcessSteps(newTestProcessSteps("a", "b", "c", "d"), []string{"id-c", "id-a"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"c", "a", "b", "d"}, getStepNames(steps))
+
+	_, err = reorderProcessSteps(newTestProcessSteps("a", "b"), []string{"id-missing"})
+	require.Error(t, err)
+
+	_, err = reorderProcessSteps(newTestProcessSteps("a", "b"), []string{"id-a", "id-a"})
+	require.Error(t, err)
+}
+
+func TestFindProcessStep(t *testing.T) {
+	steps := newTestProcessSteps("a", "b")
+	require.Equal(t, "b", findProcessStep(steps, "id-b", "").Name)
+	require.Equal(t, "a", findProcessStep(steps, "id-missing", "a").Name)
+	require.Nil(t, findProcessStep(steps, "id-missing", "missing"))
+}
+
+func TestIsRunbookProcessID(t *testing.T) {
+	require.True(t, isRunbookProcessID("RunbookProcess-Runbooks-1"))
+	require.False(t, isRunbookProcessID("deploymentprocess-Projects-1"))
+}
@@ -11,6 +11,7 @@ import (
 func Provider() *schema.Provider {
 	return &schema.Provider{
 		DataSourcesMap: map[string]*schema.Resource{
+			"octopusdeploy_account":                                         dataSourceAccount(),
 			"octopusdeploy_accounts":                                        dataSourceAccounts(),
 			"octopusdeploy_azure_cloud_service_deployment_targets":          dataSourceAzureCloudServiceDeploymentTargets(),
 			"octopusdeploy_azure_service_fabric_cluster_deployment_targets": dataSourceAzureServiceFabricClusterDeploymentTargets(),
@@ -19,35 +20,51 @@ func Provider() *schema.Provider {
 			"octopusdeploy_cloud_region_deployment_targets":                 dataSourceCloudRegionDeploymentTargets(),
 			"octopusdeploy_channels":                                        dataSourceChannels(),
 			"octopusdeploy_deployment_targets":                              dataSourceDeploymentTargets(),
+			"octopusdeploy_environment":                                     dataSourceEnvironment(),
 			"octopusdeploy_environments":                                    dataSourceEnvironments(),
+			"octopusdeploy_expiring_certificates":                           dataSourceExpiringCertificates(),
+			"octopusdeploy_feed":                                            dataSourceFeed(),
 			"octopusdeploy_feeds":                                           dataSourceFeeds(),
 			"octopusdeploy_git_credentials":                                 dataSourceGitCredentials(),
+			"octopusdeploy_insights_metrics":                                dataSourceInsightsMetrics(),
 			"octopusdeploy_kubernetes_cluster_deployment_targets":           dataSourceKubernetesClusterDeploymentTargets(),
 			"octopusdeploy_library_variable_sets":                           dataSourceLibraryVariableSet(),
+			"octopusdeploy_lifecycle":                                       dataSourceLifecycle(),
 			"octopusdeploy_lifecycles":                                      dataSourceLifecycles(),
 			"octopusdeploy_listening_tentacle_deployment_targets":           dataSourceListeningTentacleDeploymentTargets(),
 			"octopusdeploy_machine":                                         dataSourceMachine(),
 			"octopusdeploy_machine_policies":                                dataSourceMachinePolicies(),
+			"octopusdeploy_machine_proxies":                                 dataSourceMachineProxies(),
+			"octopusdeploy_machine_proxy":                                   dataSourceMachineProxy(),
 			"octopusdeploy_offline_package_drop_deployment_targets":         dataSourceOfflinePackageDropDeploymentTargets(),
 			"octopusdeploy_polling_tentacle_deployment_targets":             dataSourcePollingTentacleDeploymentTargets(),
+			"octopusdeploy_project":                                         dataSourceProject(),
 			"octopusdeploy_project_groups":                                  dataSourceProjectGroups(),
 			"octopusdeploy_projects":                                        dataSourceProjects(),
 			"octopusdeploy_script_modules":                                  dataSourceScriptModules(),
+			"octopusdeploy_service_account_oidc_identities":                 dataSourceServiceAccountOIDCIdentities(),
 			"octopusdeploy_space":                                           dataSourceSpace(),
 			"octopusdeploy_spaces":                                          dataSourceSpaces(),
 			"octopusdeploy_ssh_connection_deployment_targets":               dataSourceSSHConnectionDeploymentTargets(),
 			"octopusdeploy_tag_sets":                                        dataSourceTagSets(),
+			"octopusdeploy_team_permissions":                                dataSourceTeamPermissions(),
 			"octopusdeploy_teams":                                           dataSourceTeams(),
+			"octopusdeploy_tenant":                                          dataSourceTenant(),
 			"octopusdeploy_tenants":                                         dataSourceTenants(),
 			"octopusdeploy_users":                                           dataSourceUsers(),
+			"octopusdeploy_user_permissions":                                dataSourceUserPermissions(),
 			"octopusdeploy_user_roles":                                      dataSourceUserRoles(),
 			"octopusdeploy_variables":                                       dataSourceVariable(),
+			"octopusdeploy_worker_pool":                                     dataSourceWorkerPool(),
 			"octopusdeploy_worker_pools":                                    dataSourceWorkerPools(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"octopusdeploy_aws_account":                                    resourceAmazonWebServicesAccount(),
 			"octopusdeploy_aws_elastic_container_registry":                 resourceAwsElasticContainerRegistry(),
+			"octopusdeploy_aws_openid_connect_account":                     resourceAmazonWebServicesOpenIDConnectAccount(),
+			"octopusdeploy_azure_ad_authentication":                        resourceAzureADAuthentication(),
 			"octopusdeploy_azure_cloud_service_deployment_target":          resourceAzureCloudServiceDeploymentTarget(),
+			"octopusdeploy_azure_openid_connect":                           resourceAzureOpenIDConnectAccount(),
 			"octopusdeploy_azure_service_fabric_cluster_deployment_target": resourceAzureServiceFabricClusterDeploymentTarget(),
 			"octopusdeploy_azure_service_principal":                        resourceAzureServicePrincipalAccount(),
 			"octopusdeploy_azure_subscription_account":                     resourceAzureSubscriptionAccount(),
@@ -62,16 +79,26 @@ func Provider() *schema.Provider {
 			"octopusdeploy_git_credential":                                 resourceGitCredential(),
 			"octopusdeploy_github_repository_feed":                         resourceGitHubRepositoryFeed(),
 			"octopusdeploy_gcp_account":                                    resourceGoogleCloudPlatformAccount(),
+			"octopusdeploy_gcp_openid_connect_account":                     resourceGoogleCloudPlatformOpenIDConnectAccount(),
+			"octopusdeploy_google_workspace_authentication":                resourceGoogleWorkspaceAuthentication(),
 			"octopusdeploy_helm_feed":                                      resourceHelmFeed(),
+			"octopusdeploy_insights_report":                                resourceInsightsReport(),
 			"octopusdeploy_kubernetes_cluster_deployment_target":           resourceKubernetesClusterDeploymentTarget(),
+			"octopusdeploy_ldap_authentication":                            resourceLDAPAuthentication(),
 			"octopusdeploy_library_variable_set":                           resourceLibraryVariableSet(),
 			"octopusdeploy_lifecycle":                                      resourceLifecycle(),
 			"octopusdeploy_listening_tentacle_deployment_target":           resourceListeningTentacleDeploymentTarget(),
 			"octopusdeploy_machine_policy":                                 resourceMachinePolicy(),
+			"octopusdeploy_machine_proxy":                                  resourceMachineProxy(),
+			"octopusdeploy_maintenance_configuration":                      resourceMaintenanceConfiguration(),
 			"octopusdeploy_maven_feed":                                     resourceMavenFeed(),
 			"octopusdeploy_nuget_feed":                                     resourceNuGetFeed(),
 			"octopusdeploy_offline_package_drop_deployment_target":         resourceOfflinePackageDropDeploymentTarget(),
+			"octopusdeploy_okta_authentication":                            resourceOktaAuthentication(),
+			"octopusdeploy_openid_connect_authentication":                  resourceOpenIDConnectAuthentication(),
 			"octopusdeploy_polling_tentacle_deployment_target":             resourcePollingTentacleDeploymentTarget(),
+			"octopusdeploy_process_step":                                   resourceProcessStep(),
+			"octopusdeploy_process_step_order":                             resourceProcessStepOrder(),
 			"octopusdeploy_project":                                        resourceProject(),
 			"octopusdeploy_project_deployment_target_trigger":              resourceProjectDeploymentTargetTrigger(),
 			"octopusdeploy_project_group":                                  resourceProjectGroup(),
@@ -79,21 +106,33 @@ func Provider() *schema.Provider {
 			"octopusdeploy_runbook_process":                                resourceRunbookProcess(),
 			"octopusdeploy_scoped_user_role":                               resourceScopedUserRole(),
 			"octopusdeploy_script_module":                                  resourceScriptModule(),
+			"octopusdeploy_server_folders":                                 resourceServerFolders(),
+			"octopusdeploy_server_task_cap":                                resourceServerTaskCap(),
+			"octopusdeploy_service_account_oidc_identity":                  resourceServiceAccountOIDCIdentity(),
+			"octopusdeploy_smtp_configuration":                             resourceSMTPConfiguration(),
 			"octopusdeploy_space":                                          resourceSpace(),
 			"octopusdeploy_ssh_connection_deployment_target":               resourceSSHConnectionDeploymentTarget(),
 			"octopusdeploy_ssh_key_account":                                resourceSSHKeyAccount(),
 			"octopusdeploy_static_worker_pool":                             resourceStaticWorkerPool(),
+			"octopusdeploy_subscription":                                   resourceSubscription(),
 			"octopusdeploy_tag":                                            resourceTag(),
 			"octopusdeploy_tag_set":                                        resourceTagSet(),
 			"octopusdeploy_team":                                           resourceTeam(),
+			"octopusdeploy_team_external_group":                            resourceTeamExternalGroup(),
+			"octopusdeploy_team_membership":                                resourceTeamMembership(),
 			"octopusdeploy_tenant":                                         resourceTenant(),
 			"octopusdeploy_tenant_common_variable":                         resourceTenantCommonVariable(),
+			"octopusdeploy_tenant_project":                                 resourceTenantProject(),
 			"octopusdeploy_tenant_project_variable":                        resourceTenantProjectVariable(),
+			"octopusdeploy_tentacle_upgrade":                               resourceTentacleUpgrade(),
 			"octopusdeploy_token_account":                                  resourceTokenAccount(),
 			"octopusdeploy_user":                                           resourceUser(),
+			"octopusdeploy_user_api_key":                                   resourceUserAPIKey(),
 			"octopusdeploy_user_role":                                      resourceUserRole(),
 			"octopusdeploy_username_password_account":                      resourceUsernamePasswordAccount(),
+			"octopusdeploy_username_password_authentication":               resourceUsernamePasswordAuthentication(),
 			"octopusdeploy_variable":                                       resourceVariable(),
+			"octopusdeploy_variable_set":                                   resourceVariableSet(),
 		},
 		Schema: map[string]*schema.Schema{
 			"address": {
@@ -14,16 +14,21 @@ import (
 func resourceAmazonWebServicesAccount() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceAmazonWebServicesAccountCreate,
+		CustomizeDiff: customizeTenantTagsDiff,
 		DeleteContext: resourceAmazonWebServicesAccountDelete,
 		Description:   "This resource manages AWS accounts in Octopus Deploy.",
 		Importer:      getImporter(),
 		ReadContext:   resourceAmazonWebServicesAccountRead,
-		Schema:        getAmazonWebServicesAccountSchema(),
+		Schema:        addTenantTagSchema(getAmazonWebServicesAccountSchema()),
 		UpdateContext: resourceAmazonWebServicesAccountUpdate,
 	}
 }
 
 func resourceAmazonWebServicesAccountCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateResourceTenantTags(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
 	account := expandAmazonWebServicesAccount(d)
 
 	log.Printf("[INFO] creating AWS account")
@@ -77,6 +82,10 @@ func resourceAmazonWebServicesAccountRead(ctx context.Context, d *schema.Resourc
 }
 
 func resourceAmazonWebServicesAccountUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateResourceTenantTags(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
 	account := expandAmazonWebServicesAccount(d)
 
 	log.Printf("[INFO] updating AWS account: %#v", account)
@@ -32,7 +32,7 @@ func TestAccAWSAccountBasic(t *testing.T) {
 					resource.TestCheckResourceAttr(prefix, "access_key", accessKey),
 					resource.TestCheckResourceAttr(prefix, "description", description),
 					resource.TestCheckResourceAttr(prefix, "name", name),
-					resource.TestCheckResourceAttr(prefix, "secret_key", secretKey),
+					resource.TestCheckResourceAttr(prefix, "secret_key", hashAccountSecret(secretKey)),
 					resource.TestCheckResourceAttr(prefix, "tenanted_deployment_participation", string(tenantedDeploymentParticipation)),
 				),
 				Config: testAwsAccountBasic(localName, name, description, accessKey, secretKey, tenantedDeploymentParticipation),
@@ -43,7 +43,7 @@ func TestAccAWSAccountBasic(t *testing.T) {
 					resource.TestCheckResourceAttr(prefix, "access_key", newAccessKey),
 					resource.TestCheckResourceAttr(prefix, "description", description),
 					resource.TestCheckResourceAttr(prefix, "name", name),
-					resource.TestCheckResourceAttr(prefix, "secret_key", secretKey),
+					resource.TestCheckResourceAttr(prefix, "secret_key", hashAccountSecret(secretKey)),
 					resource.TestCheckResourceAttr(prefix, "tenanted_deployment_participation", string(tenantedDeploymentParticipation)),
 				),
 				Config: testAwsAccountBasic(localName, name, description, newAccessKey, secretKey, tenantedDeploymentParticipation),
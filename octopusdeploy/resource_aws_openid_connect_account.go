@@ -0,0 +1,104 @@
+package octopusdeploy
+
+import (
+	"context"
+	"log"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/oidcaccounts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAmazonWebServicesOpenIDConnectAccount() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAmazonWebServicesOpenIDConnectAccountCreate,
+		CustomizeDiff: customizeTenantTagsDiff,
+		DeleteContext: resourceAmazonWebServicesOpenIDConnectAccountDelete,
+		Description:   "This resource manages AWS accounts that assume an IAM role with OpenID Connect in Octopus Deploy.",
+		Importer:      getImporter(),
+		ReadContext:   resourceAmazonWebServicesOpenIDConnectAccountRead,
+		Schema:        addTenantTagSchema(getAmazonWebServicesOpenIDConnectAccountSchema()),
+		UpdateContext: resourceAmazonWebServicesOpenIDConnectAccountUpdate,
+	}
+}
+
+func resourceAmazonWebServicesOpenIDConnectAccountCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateResourceTenantTags(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
+	account := expandAmazonWebServicesOpenIDConnectAccount(d)
+
+	log.Printf("[INFO] creating AWS OpenID Connect account: %#v", account)
+
+	client := m.(*client.Client)
+	createdAccount, err := oidcaccounts.Add(client, account.SpaceID, account)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setAmazonWebServicesOpenIDConnectAccount(ctx, d, createdAccount); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(createdAccount.GetID())
+
+	log.Printf("[INFO] AWS OpenID Connect account created (%s)", d.Id())
+	return nil
+}
+
+func resourceAmazonWebServicesOpenIDConnectAccountDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] deleting AWS OpenID Connect account (%s)", d.Id())
+
+	client := m.(*client.Client)
+	if err := oidcaccounts.DeleteByID(client, d.Get("space_id").(string), d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] AWS OpenID Connect account deleted")
+	return nil
+}
+
+func resourceAmazonWebServicesOpenIDConnectAccountRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading AWS OpenID Connect account (%s)", d.Id())
+
+	client := m.(*client.Client)
+	account, err := oidcaccounts.GetByID[oidcaccounts.AmazonWebServicesOIDCAccount](client, d.Get("space_id").(string), d.Id())
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "AWS OpenID Connect account")
+	}
+
+	if err := setAmazonWebServicesOpenIDConnectAccount(ctx, d, account); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] AWS OpenID Connect account read (%s)", d.Id())
+	return nil
+}
+
+func resourceAmazonWebServicesOpenIDConnectAccountUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateResourceTenantTags(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
+	account := expandAmazonWebServicesOpenIDConnectAccount(d)
+
+	log.Printf("[INFO] updating AWS OpenID Connect account: %#v", account)
+
+	client := m.(*client.Client)
+	updatedAccount, err := oidcaccounts.Update(client, account.SpaceID, d.Id(), account)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setAmazonWebServicesOpenIDConnectAccount(ctx, d, updatedAccount); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] AWS OpenID Connect account updated (%s)", d.Id())
+	return nil
+}
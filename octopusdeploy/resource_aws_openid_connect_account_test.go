@@ -0,0 +1,53 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSOpenIDConnectAccountBasic(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_aws_openid_connect_account." + localName
+
+	name := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	roleArn := "arn:aws:iam::123456789012:role/" + acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testOpenIDConnectAccountCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testOpenIDConnectAccountExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "execution_subject_keys.#", "2"),
+					resource.TestCheckResourceAttr(prefix, "name", name),
+					resource.TestCheckResourceAttr(prefix, "role_arn", roleArn),
+					resource.TestCheckResourceAttr(prefix, "session_duration", "3600"),
+				),
+				Config: testAWSOpenIDConnectAccountBasic(localName, name, roleArn),
+			},
+			{
+				ResourceName:      prefix,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAWSOpenIDConnectAccountBasic(localName string, name string, roleArn string) string {
+	return fmt.Sprintf(`resource "octopusdeploy_aws_openid_connect_account" "%s" {
+		execution_subject_keys = ["space", "project"]
+		name                   = "%s"
+		role_arn               = "%s"
+	}
+
+	data "octopusdeploy_accounts" "test" {
+		account_type = "AmazonWebServicesOidcAccount"
+		ids          = [octopusdeploy_aws_openid_connect_account.%s.id]
+	}`, localName, name, roleArn, localName)
+}
@@ -0,0 +1,35 @@
+package octopusdeploy
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/authentication"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var azureADAuthenticationProvider = &authenticationProvider{
+	configurationID: authentication.AzureADConfigurationID,
+	expand: func(d *schema.ResourceData) interface{} {
+		return &authentication.AzureADConfiguration{
+			OpenIDConnectSettings: expandOpenIDConnectSettings(d),
+		}
+	},
+	name: "Azure AD",
+	read: func(ctx context.Context, d *schema.ResourceData, client *client.Client) error {
+		configuration, err := authentication.GetConfiguration[authentication.AzureADConfiguration](client, authentication.AzureADConfigurationID)
+		if err != nil {
+			return err
+		}
+
+		setOpenIDConnectSettings(d, configuration.OpenIDConnectSettings)
+		return nil
+	},
+}
+
+func resourceAzureADAuthentication() *schema.Resource {
+	return azureADAuthenticationProvider.resource(
+		"This resource manages the Azure Active Directory (Microsoft Entra ID) authentication provider of the Octopus Server. Destroying this resource removes it from the state and leaves the provider as it is, unless `disable_on_destroy` is set.",
+		getOpenIDConnectSettingsSchema("Azure AD"),
+	)
+}
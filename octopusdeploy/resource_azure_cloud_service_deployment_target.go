@@ -8,22 +8,29 @@ import (
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/machines"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAzureCloudServiceDeploymentTarget() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceAzureCloudServiceDeploymentTargetCreate,
+		CustomizeDiff: customdiff.All(customizeTenantTagsDiff, validateWaitForHealthDiff),
 		DeleteContext: resourceAzureCloudServiceDeploymentTargetDelete,
 		Description:   "This resource manages Azure cloud service deployment targets in Octopus Deploy.",
 		Importer:      getImporter(),
 		ReadContext:   resourceAzureCloudServiceDeploymentTargetRead,
-		Schema:        getAzureCloudServiceDeploymentTargetSchema(),
+		Schema:        addTenantTagSchema(addWaitForHealthSchema(getAzureCloudServiceDeploymentTargetSchema())),
+		Timeouts:      getWaitForHealthTimeouts(),
 		UpdateContext: resourceAzureCloudServiceDeploymentTargetUpdate,
 	}
 }
 
 func resourceAzureCloudServiceDeploymentTargetCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateResourceTenantTags(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
 	deploymentTarget := expandAzureCloudServiceDeploymentTarget(d)
 
 	log.Printf("[INFO] creating Azure cloud service deployment target: %#v", deploymentTarget)
@@ -41,7 +48,7 @@ func resourceAzureCloudServiceDeploymentTargetCreate(ctx context.Context, d *sch
 	d.SetId(createdDeploymentTarget.GetID())
 
 	log.Printf("[INFO] Azure cloud service deployment target created (%s)", d.Id())
-	return nil
+	return waitForDeploymentTargetHealth(ctx, d, client)
 }
 
 func resourceAzureCloudServiceDeploymentTargetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -76,6 +83,10 @@ func resourceAzureCloudServiceDeploymentTargetRead(ctx context.Context, d *schem
 }
 
 func resourceAzureCloudServiceDeploymentTargetUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateResourceTenantTags(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
 	log.Printf("[INFO] updating Azure cloud service deployment target (%s)", d.Id())
 
 	deploymentTarget := expandAzureCloudServiceDeploymentTarget(d)
@@ -90,5 +101,5 @@ func resourceAzureCloudServiceDeploymentTargetUpdate(ctx context.Context, d *sch
 	}
 
 	log.Printf("[INFO] Azure cloud service deployment target updated (%s)", d.Id())
-	return nil
+	return waitForDeploymentTargetHealth(ctx, d, client)
 }
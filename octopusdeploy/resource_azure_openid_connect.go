@@ -0,0 +1,95 @@
+package octopusdeploy
+
+import (
+	"context"
+	"log"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/oidcaccounts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAzureOpenIDConnectAccount() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAzureOpenIDConnectAccountCreate,
+		DeleteContext: resourceAzureOpenIDConnectAccountDelete,
+		Description:   "This resource manages Azure accounts that authenticate with OpenID Connect in Octopus Deploy.",
+		Importer:      getImporter(),
+		ReadContext:   resourceAzureOpenIDConnectAccountRead,
+		Schema:        getAzureOpenIDConnectAccountSchema(),
+		UpdateContext: resourceAzureOpenIDConnectAccountUpdate,
+	}
+}
+
+func resourceAzureOpenIDConnectAccountCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	account := expandAzureOpenIDConnectAccount(d)
+
+	log.Printf("[INFO] creating Azure OpenID Connect account: %#v", account)
+
+	client := m.(*client.Client)
+	createdAccount, err := oidcaccounts.Add(client, account.SpaceID, account)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setAzureOpenIDConnectAccount(ctx, d, createdAccount); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(createdAccount.GetID())
+
+	log.Printf("[INFO] Azure OpenID Connect account created (%s)", d.Id())
+	return nil
+}
+
+func resourceAzureOpenIDConnectAccountDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] deleting Azure OpenID Connect account (%s)", d.Id())
+
+	client := m.(*client.Client)
+	if err := oidcaccounts.DeleteByID(client, d.Get("space_id").(string), d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] Azure OpenID Connect account deleted")
+	return nil
+}
+
+func resourceAzureOpenIDConnectAccountRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading Azure OpenID Connect account (%s)", d.Id())
+
+	client := m.(*client.Client)
+	account, err := oidcaccounts.GetByID[oidcaccounts.AzureOIDCAccount](client, d.Get("space_id").(string), d.Id())
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "Azure OpenID Connect account")
+	}
+
+	if err := setAzureOpenIDConnectAccount(ctx, d, account); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Azure OpenID Connect account read (%s)", d.Id())
+	return nil
+}
+
+func resourceAzureOpenIDConnectAccountUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	account := expandAzureOpenIDConnectAccount(d)
+
+	log.Printf("[INFO] updating Azure OpenID Connect account: %#v", account)
+
+	client := m.(*client.Client)
+	updatedAccount, err := oidcaccounts.Update(client, account.SpaceID, d.Id(), account)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setAzureOpenIDConnectAccount(ctx, d, updatedAccount); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Azure OpenID Connect account updated (%s)", d.Id())
+	return nil
+}
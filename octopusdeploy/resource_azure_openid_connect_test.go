@@ -0,0 +1,49 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAzureOpenIDConnectAccountBasic(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_azure_openid_connect." + localName
+
+	applicationID := uuid.New().String()
+	name := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	subscriptionID := uuid.New().String()
+	tenantID := uuid.New().String()
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testOpenIDConnectAccountCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testOpenIDConnectAccountExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "application_id", applicationID),
+					resource.TestCheckResourceAttr(prefix, "audience", "api://AzureADTokenExchange"),
+					resource.TestCheckResourceAttr(prefix, "name", name),
+					resource.TestCheckResourceAttr(prefix, "subscription_id", subscriptionID),
+					resource.TestCheckResourceAttr(prefix, "tenant_id", tenantID),
+				),
+				Config: testAzureOpenIDConnectAccountBasic(localName, name, applicationID, subscriptionID, tenantID),
+			},
+		},
+	})
+}
+
+func testAzureOpenIDConnectAccountBasic(localName string, name string, applicationID string, subscriptionID string, tenantID string) string {
+	return fmt.Sprintf(`resource "octopusdeploy_azure_openid_connect" "%s" {
+		application_id      = "%s"
+		health_subject_keys = ["space", "target"]
+		name                = "%s"
+		subscription_id     = "%s"
+		tenant_id           = "%s"
+	}`, localName, applicationID, name, subscriptionID, tenantID)
+}
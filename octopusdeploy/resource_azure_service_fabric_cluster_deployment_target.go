@@ -8,22 +8,29 @@ import (
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/machines"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAzureServiceFabricClusterDeploymentTarget() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceAzureServiceFabricClusterDeploymentTargetCreate,
+		CustomizeDiff: customdiff.All(customizeTenantTagsDiff, validateWaitForHealthDiff),
 		DeleteContext: resourceAzureServiceFabricClusterDeploymentTargetDelete,
 		Description:   "This resource manages Azure service fabric cluster deployment targets in Octopus Deploy.",
 		Importer:      getImporter(),
 		ReadContext:   resourceAzureServiceFabricClusterDeploymentTargetRead,
-		Schema:        getAzureServiceFabricClusterDeploymentTargetSchema(),
+		Schema:        addTenantTagSchema(addWaitForHealthSchema(getAzureServiceFabricClusterDeploymentTargetSchema())),
+		Timeouts:      getWaitForHealthTimeouts(),
 		UpdateContext: resourceAzureServiceFabricClusterDeploymentTargetUpdate,
 	}
 }
 
 func resourceAzureServiceFabricClusterDeploymentTargetCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateResourceTenantTags(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
 	deploymentTarget := expandAzureServiceFabricClusterDeploymentTarget(d)
 
 	log.Printf("[INFO] creating Azure service fabric cluster deployment target: %#v", deploymentTarget)
@@ -41,7 +48,7 @@ func resourceAzureServiceFabricClusterDeploymentTargetCreate(ctx context.Context
 	d.SetId(createdDeploymentTarget.GetID())
 
 	log.Printf("[INFO] Azure service fabric cluster deployment target created (%s)", d.Id())
-	return nil
+	return waitForDeploymentTargetHealth(ctx, d, client)
 }
 
 func resourceAzureServiceFabricClusterDeploymentTargetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -76,6 +83,10 @@ func resourceAzureServiceFabricClusterDeploymentTargetRead(ctx context.Context,
 }
 
 func resourceAzureServiceFabricClusterDeploymentTargetUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateResourceTenantTags(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
 	log.Printf("[INFO] updating Azure service fabric cluster deployment target (%s)", d.Id())
 
 	deploymentTarget := expandAzureServiceFabricClusterDeploymentTarget(d)
@@ -90,5 +101,5 @@ func resourceAzureServiceFabricClusterDeploymentTargetUpdate(ctx context.Context
 	}
 
 	log.Printf("[INFO] Azure service fabric cluster deployment target updated (%s)", d.Id())
-	return nil
+	return waitForDeploymentTargetHealth(ctx, d, client)
 }
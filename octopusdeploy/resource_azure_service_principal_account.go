@@ -14,16 +14,21 @@ import (
 func resourceAzureServicePrincipalAccount() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceAzureServicePrincipalAccountCreate,
+		CustomizeDiff: customizeTenantTagsDiff,
 		DeleteContext: resourceAzureServicePrincipalAccountDelete,
 		Description:   "This resource manages Azure service principal accounts in Octopus Deploy.",
 		Importer:      getImporter(),
 		ReadContext:   resourceAzureServicePrincipalAccountRead,
-		Schema:        getAzureServicePrincipalAccountSchema(),
+		Schema:        addTenantTagSchema(getAzureServicePrincipalAccountSchema()),
 		UpdateContext: resourceAzureServicePrincipalAccountUpdate,
 	}
 }
 
 func resourceAzureServicePrincipalAccountCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateResourceTenantTags(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
 	account := expandAzureServicePrincipalAccount(d)
 
 	log.Printf("[INFO] creating Azure service principal account: %#v", account)
@@ -77,6 +82,10 @@ func resourceAzureServicePrincipalAccountRead(ctx context.Context, d *schema.Res
 }
 
 func resourceAzureServicePrincipalAccountUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateResourceTenantTags(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
 	account := expandAzureServicePrincipalAccount(d)
 
 	log.Printf("[INFO] updating Azure service principal account %#v", account)
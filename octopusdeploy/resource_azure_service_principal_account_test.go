@@ -35,7 +35,7 @@ func TestAccOctopusDeployAzureServicePrincipalAccountBasic(t *testing.T) {
 					resource.TestCheckResourceAttr(prefix, "application_id", applicationID.String()),
 					resource.TestCheckResourceAttr(prefix, "description", description),
 					resource.TestCheckResourceAttr(prefix, "name", name),
-					resource.TestCheckResourceAttr(prefix, "password", password),
+					resource.TestCheckResourceAttr(prefix, "password", hashAccountSecret(password)),
 					resource.TestCheckResourceAttr(prefix, "subscription_id", subscriptionID.String()),
 					resource.TestCheckResourceAttr(prefix, "tenant_id", tenantID.String()),
 					resource.TestCheckResourceAttr(prefix, "tenanted_deployment_participation", string(tenantedDeploymentMode)),
@@ -48,7 +48,7 @@ func TestAccOctopusDeployAzureServicePrincipalAccountBasic(t *testing.T) {
 					resource.TestCheckResourceAttr(prefix, "application_id", applicationID.String()),
 					resource.TestCheckResourceAttr(prefix, "description", newDescription),
 					resource.TestCheckResourceAttr(prefix, "name", name),
-					resource.TestCheckResourceAttr(prefix, "password", password),
+					resource.TestCheckResourceAttr(prefix, "password", hashAccountSecret(password)),
 					resource.TestCheckResourceAttr(prefix, "subscription_id", subscriptionID.String()),
 					resource.TestCheckResourceAttr(prefix, "tenant_id", tenantID.String()),
 					resource.TestCheckResourceAttr(prefix, "tenanted_deployment_participation", string(tenantedDeploymentMode)),
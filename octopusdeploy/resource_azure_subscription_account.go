@@ -14,16 +14,21 @@ import (
 func resourceAzureSubscriptionAccount() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceAzureSubscriptionAccountCreate,
+		CustomizeDiff: customizeTenantTagsDiff,
 		DeleteContext: resourceAzureSubscriptionAccountDelete,
 		Description:   "This resource manages Azure subscription accounts in Octopus Deploy.",
 		Importer:      getImporter(),
 		ReadContext:   resourceAzureSubscriptionAccountRead,
-		Schema:        getAzureSubscriptionAccountSchema(),
+		Schema:        addTenantTagSchema(getAzureSubscriptionAccountSchema()),
 		UpdateContext: resourceAzureSubscriptionAccountUpdate,
 	}
 }
 
 func resourceAzureSubscriptionAccountCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateResourceTenantTags(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
 	account := expandAzureSubscriptionAccount(d)
 
 	log.Printf("[INFO] creating Azure subscription account: %#v", account)
@@ -77,6 +82,10 @@ func resourceAzureSubscriptionAccountRead(ctx context.Context, d *schema.Resourc
 }
 
 func resourceAzureSubscriptionAccountUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateResourceTenantTags(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
 	account := expandAzureSubscriptionAccount(d)
 
 	log.Printf("[INFO] updating Azure subscription account %#v", account)
@@ -8,22 +8,29 @@ import (
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/machines"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAzureWebAppDeploymentTarget() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceAzureWebAppDeploymentTargetCreate,
+		CustomizeDiff: customdiff.All(customizeTenantTagsDiff, validateWaitForHealthDiff),
 		DeleteContext: resourceAzureWebAppDeploymentTargetDelete,
 		Description:   "This resource manages Azure web app deployment targets in Octopus Deploy.",
 		Importer:      getImporter(),
 		ReadContext:   resourceAzureWebAppDeploymentTargetRead,
-		Schema:        getAzureWebAppDeploymentTargetSchema(),
+		Schema:        addTenantTagSchema(addWaitForHealthSchema(getAzureWebAppDeploymentTargetSchema())),
+		Timeouts:      getWaitForHealthTimeouts(),
 		UpdateContext: resourceAzureWebAppDeploymentTargetUpdate,
 	}
 }
 
 func resourceAzureWebAppDeploymentTargetCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateResourceTenantTags(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
 	deploymentTarget := expandAzureWebAppDeploymentTarget(d)
 
 	log.Printf("[INFO] creating Azure web app deployment target: %#v", deploymentTarget)
@@ -41,7 +48,7 @@ func resourceAzureWebAppDeploymentTargetCreate(ctx context.Context, d *schema.Re
 	d.SetId(createdDeploymentTarget.GetID())
 
 	log.Printf("[INFO] Azure web app deployment target created (%s)", d.Id())
-	return nil
+	return waitForDeploymentTargetHealth(ctx, d, client)
 }
 
 func resourceAzureWebAppDeploymentTargetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -76,6 +83,10 @@ func resourceAzureWebAppDeploymentTargetRead(ctx context.Context, d *schema.Reso
 }
 
 func resourceAzureWebAppDeploymentTargetUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateResourceTenantTags(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
 	log.Printf("[INFO] updating Azure web app deployment target (%s)", d.Id())
 
 	deploymentTarget := expandAzureWebAppDeploymentTarget(d)
@@ -90,5 +101,5 @@ func resourceAzureWebAppDeploymentTargetUpdate(ctx context.Context, d *schema.Re
 	}
 
 	log.Printf("[INFO] Azure web app deployment target updated (%s)", d.Id())
-	return nil
+	return waitForDeploymentTargetHealth(ctx, d, client)
 }
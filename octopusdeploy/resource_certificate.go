@@ -6,27 +6,80 @@ import (
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/certificates"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	certs "github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/certificates"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// certificateDataKeys are the attributes of a certificate that the server reads from its data.
+var certificateDataKeys = []string{
+	"has_private_key",
+	"is_expired",
+	"issuer_common_name",
+	"issuer_distinguished_name",
+	"issuer_organization",
+	"not_after",
+	"not_before",
+	"self_signed",
+	"serial_number",
+	"signature_algorithm_name",
+	"subject_alternative_names",
+	"subject_common_name",
+	"subject_distinguished_name",
+	"subject_organization",
+	"thumbprint",
+}
+
+// certificateDataSourceKeys are the attributes that determine the data of a certificate, apart from the generate
+// block, which creates a new certificate whenever it changes.
+var certificateDataSourceKeys = []string{
+	"certificate_chain_pem",
+	"certificate_data",
+	"certificate_pem",
+	"private_key_pem",
+}
+
+// certificateReplacementKeys are the attributes that are applied by replacing a certificate in place, along with the
+// ones that only configure the resource.
+var certificateReplacementKeys = append([]string{
+	"password",
+	"replace_in_place",
+	"warn_before_expiry",
+}, certificateDataSourceKeys...)
+
 func resourceCertificate() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceCertificateCreate,
+		CustomizeDiff: customdiff.All(resourceCertificateCustomizeDiff, customizeTenantTagsDiff),
 		DeleteContext: resourceCertificateDelete,
 		Description:   "This resource manages certificates in Octopus Deploy.",
 		Importer:      getImporter(),
 		ReadContext:   resourceCertificateRead,
-		Schema:        getCertificateSchema(),
+		Schema:        addTenantTagSchema(getCertificateSchema()),
 		UpdateContext: resourceCertificateUpdate,
 	}
 }
 
 func resourceCertificateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateResourceTenantTags(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
 	certificate := expandCertificate(d)
 
-	log.Printf("[INFO] creating certificate: %#v", certificate)
+	log.Printf("[INFO] creating certificate: %s", certificate.Name)
+
+	if certificate.CertificateData == nil {
+		certificateData, err := getCertificateData(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		certificate.CertificateData = core.NewSensitiveValue(certificateData)
+	}
 
 	client := m.(*client.Client)
 	createdCertificate, err := certificates.Add(client, certificate)
@@ -41,6 +94,22 @@ func resourceCertificateCreate(ctx context.Context, d *schema.ResourceData, m in
 	d.SetId(createdCertificate.GetID())
 
 	log.Printf("[INFO] certificate created (%s)", d.Id())
+	return getCertificateExpiryDiagnostics(d)
+}
+
+// resourceCertificateCustomizeDiff marks the attributes that are read from the certificate data as unknown when the
+// certificate is replaced in place, since they describe the replacement once it is applied.
+func resourceCertificateCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if len(d.Id()) == 0 || !d.Get("replace_in_place").(bool) || !d.HasChanges(certificateDataSourceKeys...) {
+		return nil
+	}
+
+	for _, key := range certificateDataKeys {
+		if err := d.SetNewComputed(key); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -74,14 +143,52 @@ func resourceCertificateRead(ctx context.Context, d *schema.ResourceData, m inte
 	}
 
 	log.Printf("[INFO] certificate read (%s)", d.Id())
-	return nil
+	return getCertificateExpiryDiagnostics(d)
 }
 
 func resourceCertificateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateResourceTenantTags(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
 	log.Printf("[INFO] updating certificate (%s)", d.Id())
 
 	certificate := expandCertificate(d)
 	client := m.(*client.Client)
+
+	if d.Get("replace_in_place").(bool) && d.HasChanges(certificateDataSourceKeys...) {
+		log.Printf("[INFO] replacing certificate (%s)", d.Id())
+
+		certificateData, err := getCertificateData(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		replacementCertificate := certificates.NewReplacementCertificate(certificateData, d.Get("password").(string))
+		replacedCertificate, err := certs.Replace(client, certificate.SpaceID, d.Id(), replacementCertificate)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if !d.HasChangesExcept(certificateReplacementKeys...) {
+			if err := setCertificate(ctx, d, replacedCertificate); err != nil {
+				return diag.FromErr(err)
+			}
+
+			log.Printf("[INFO] certificate replaced (%s)", d.Id())
+			return getCertificateExpiryDiagnostics(d)
+		}
+
+		// the other changes are applied to the replacement, which is described by the data that was just replaced
+		replacedCertificate.EnvironmentIDs = certificate.EnvironmentIDs
+		replacedCertificate.Name = certificate.Name
+		replacedCertificate.Notes = certificate.Notes
+		replacedCertificate.TenantedDeploymentMode = certificate.TenantedDeploymentMode
+		replacedCertificate.TenantIDs = certificate.TenantIDs
+		replacedCertificate.TenantTags = certificate.TenantTags
+		certificate = replacedCertificate
+	}
+
 	updatedCertificate, err := certificates.Update(client, certificate)
 	if err != nil {
 		return diag.FromErr(err)
@@ -92,5 +199,5 @@ func resourceCertificateUpdate(ctx context.Context, d *schema.ResourceData, m in
 	}
 
 	log.Printf("[INFO] certificate updated (%s)", d.Id())
-	return nil
+	return getCertificateExpiryDiagnostics(d)
 }
@@ -15,16 +15,21 @@ import (
 func resourceChannel() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceChannelCreate,
+		CustomizeDiff: customizeTenantTagsDiff,
 		DeleteContext: resourceChannelDelete,
 		Description:   "This resource manages channels in Octopus Deploy.",
 		Importer:      getImporter(),
 		ReadContext:   resourceChannelRead,
-		Schema:        getChannelSchema(),
+		Schema:        addTenantTagSchema(getChannelSchema()),
 		UpdateContext: resourceChannelUpdate,
 	}
 }
 
 func resourceChannelCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateResourceTenantTags(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
 	mutex.Lock()
 	defer mutex.Unlock()
 
@@ -83,6 +88,10 @@ func resourceChannelRead(ctx context.Context, d *schema.ResourceData, m interfac
 }
 
 func resourceChannelUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateResourceTenantTags(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
 	mutex.Lock()
 	defer mutex.Unlock()
 
@@ -8,22 +8,29 @@ import (
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/machines"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceCloudRegionDeploymentTarget() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceCloudRegionDeploymentTargetCreate,
+		CustomizeDiff: customdiff.All(customizeTenantTagsDiff, validateWaitForHealthDiff),
 		DeleteContext: resourceCloudRegionDeploymentTargetDelete,
 		Description:   "This resource manages cloud region deployment targets in Octopus Deploy.",
 		Importer:      getImporter(),
 		ReadContext:   resourceCloudRegionDeploymentTargetRead,
-		Schema:        getCloudRegionDeploymentTargetSchema(),
+		Schema:        addTenantTagSchema(addWaitForHealthSchema(getCloudRegionDeploymentTargetSchema())),
+		Timeouts:      getWaitForHealthTimeouts(),
 		UpdateContext: resourceCloudRegionDeploymentTargetUpdate,
 	}
 }
 
 func resourceCloudRegionDeploymentTargetCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateResourceTenantTags(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
 	deploymentTarget := expandCloudRegionDeploymentTarget(d)
 
 	log.Printf("[INFO] creating cloud region deployment target: %#v", deploymentTarget)
@@ -41,7 +48,7 @@ func resourceCloudRegionDeploymentTargetCreate(ctx context.Context, d *schema.Re
 	d.SetId(createdDeploymentTarget.GetID())
 
 	log.Printf("[INFO] cloud region deployment target created (%s)", d.Id())
-	return nil
+	return waitForDeploymentTargetHealth(ctx, d, client)
 }
 
 func resourceCloudRegionDeploymentTargetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -76,6 +83,10 @@ func resourceCloudRegionDeploymentTargetRead(ctx context.Context, d *schema.Reso
 }
 
 func resourceCloudRegionDeploymentTargetUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateResourceTenantTags(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
 	log.Printf("[INFO] updating cloud region deployment target (%s)", d.Id())
 
 	deploymentTarget := expandCloudRegionDeploymentTarget(d)
@@ -90,5 +101,5 @@ func resourceCloudRegionDeploymentTargetUpdate(ctx context.Context, d *schema.Re
 	}
 
 	log.Printf("[INFO] cloud region deployment target updated (%s)", d.Id())
-	return nil
+	return waitForDeploymentTargetHealth(ctx, d, client)
 }
@@ -55,6 +55,47 @@ func TestAccCloudRegionDeploymentTargetBasic(t *testing.T) {
 	})
 }
 
+func TestAccCloudRegionDeploymentTargetWaitForHealth(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	resourceName := "octopusdeploy_cloud_region_deployment_target." + localName
+
+	name := acctest.RandStringFromCharSet(16, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccCloudRegionDeploymentTargetCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudRegionDeploymentTargetWaitForHealth(localName, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCloudRegionDeploymentTargetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "health_status", "Healthy"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_health.0.timeout", "5m"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudRegionDeploymentTargetWaitForHealth(localName string, name string) string {
+	environmentLocalName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	environmentName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	return fmt.Sprintf(testAccEnvironment(environmentLocalName, environmentName, "", false, 0, false)+"\n"+`
+		resource "octopusdeploy_cloud_region_deployment_target" "%s" {
+		  default_worker_pool_id = "WorkerPools-41"
+		  environments           = ["${octopusdeploy_environment.%s.id}"]
+		  name                   = "%s"
+		  roles                  = ["Prod"]
+
+		  wait_for_health {
+		    required_status = ["Healthy"]
+		    timeout         = "5m"
+		  }
+	    }`, localName, environmentLocalName, name)
+}
+
 func testAccCloudRegionDeploymentTargetBasic(localName string, name string) string {
 	allowDynamicInfrastructure := false
 	environmentDescription := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
@@ -17,6 +17,7 @@ import (
 func resourceDeploymentProcess() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceDeploymentProcessCreate,
+		CustomizeDiff: validateNestedTenantTagsDiff("step"),
 		DeleteContext: resourceDeploymentProcessDelete,
 		Description:   "This resource manages deployment processes in Octopus Deploy.",
 		Importer:      getImporter(),
@@ -27,13 +28,15 @@ func resourceDeploymentProcess() *schema.Resource {
 }
 
 func getDeploymentProcessSchema() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
+	deploymentProcessSchema := map[string]*schema.Schema{
 		"id": getIDSchema(),
 		"branch": {
-			Computed:    true,
-			Description: "The branch name associated with this deployment process (i.e. `main`). This value is optional and only applies to associated projects that are stored in version control.",
-			Optional:    true,
-			Type:        schema.TypeString,
+			Computed:      true,
+			ConflictsWith: []string{"git_ref"},
+			Deprecated:    "This attribute is deprecated; please use git_ref instead.",
+			Description:   "The branch name associated with this deployment process (i.e. `main`). This value is optional and only applies to associated projects that are stored in version control.",
+			Optional:      true,
+			Type:          schema.TypeString,
 		},
 		"last_snapshot_id": {
 			Optional: true,
@@ -53,9 +56,17 @@ func getDeploymentProcessSchema() map[string]*schema.Schema {
 			Type:        schema.TypeInt,
 		},
 	}
+
+	addGitRefSchema(deploymentProcessSchema, true)
+
+	return deploymentProcessSchema
 }
 
 func resourceDeploymentProcessCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateNestedResourceTenantTags(ctx, d, m, "step"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	client := m.(*client.Client)
 	deploymentProcess, err := expandDeploymentProcess(ctx, d, client)
 
@@ -71,7 +82,7 @@ func resourceDeploymentProcessCreate(ctx context.Context, d *schema.ResourceData
 	}
 
 	var current *deployments.DeploymentProcess
-	if project.PersistenceSettings != nil && project.PersistenceSettings.Type() == projects.PersistenceSettingsTypeVersionControlled {
+	if getGitPersistenceSettings(project) != nil {
 		current, err = client.DeploymentProcesses.Get(project, deploymentProcess.Branch)
 		if err != nil {
 			return diag.FromErr(err)
@@ -87,7 +98,7 @@ func resourceDeploymentProcessCreate(ctx context.Context, d *schema.ResourceData
 	deploymentProcess.Links = current.Links
 	deploymentProcess.Version = current.Version
 
-	createdDeploymentProcess, err := client.DeploymentProcesses.Update(deploymentProcess)
+	createdDeploymentProcess, err := updateDeploymentProcess(d, client, project, deploymentProcess)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -97,7 +108,7 @@ func resourceDeploymentProcessCreate(ctx context.Context, d *schema.ResourceData
 	}
 
 	id := createdDeploymentProcess.GetID()
-	if project.PersistenceSettings != nil && project.PersistenceSettings.Type() == projects.PersistenceSettingsTypeVersionControlled {
+	if getGitPersistenceSettings(project) != nil {
 		id = "deploymentprocess-" + createdDeploymentProcess.ProjectID + "-" + deploymentProcess.Branch
 	}
 
@@ -144,12 +155,13 @@ func resourceDeploymentProcessDelete(ctx context.Context, d *schema.ResourceData
 	}
 
 	deploymentProcess := &deployments.DeploymentProcess{
+		Branch:  current.Branch,
 		Version: current.Version,
 	}
 	deploymentProcess.Links = current.Links
 	deploymentProcess.ID = d.Id()
 
-	_, err = client.DeploymentProcesses.Update(deploymentProcess)
+	_, err = updateDeploymentProcess(d, client, project, deploymentProcess)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -196,6 +208,10 @@ func resourceDeploymentProcessRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceDeploymentProcessUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateNestedResourceTenantTags(ctx, d, m, "step"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	log.Printf("[INFO] updating deployment process (%s)", d.Id())
 
 	client := m.(*client.Client)
@@ -205,12 +221,13 @@ func resourceDeploymentProcessUpdate(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 
+	var project *projects.Project
 	current, err := client.DeploymentProcesses.GetByID(d.Id())
 	if err != nil {
 		r, _ := regexp.Compile(`Projects-\d+`)
 		projectID := r.FindString(d.Id())
 
-		project, err := client.Projects.GetByID(projectID)
+		project, err = client.Projects.GetByID(projectID)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -220,7 +237,7 @@ func resourceDeploymentProcessUpdate(ctx context.Context, d *schema.ResourceData
 			return diag.Errorf("you cannot change a deployment processes branch. instead create a new resource with the new branch and, if required, destroy the previous one")
 		}
 
-		if project.PersistenceSettings != nil && project.PersistenceSettings.Type() == projects.PersistenceSettingsTypeVersionControlled {
+		if getGitPersistenceSettings(project) != nil {
 			deploymentProcess.ID = "deploymentprocess-" + projectID + "-" + deploymentProcess.Branch
 			d.SetId(deploymentProcess.ID)
 		}
@@ -235,7 +252,7 @@ func resourceDeploymentProcessUpdate(ctx context.Context, d *schema.ResourceData
 	deploymentProcess.Links = current.Links
 	deploymentProcess.Version = current.Version
 
-	updatedDeploymentProcess, err := client.DeploymentProcesses.Update(deploymentProcess)
+	updatedDeploymentProcess, err := updateDeploymentProcess(d, client, project, deploymentProcess)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -248,6 +265,33 @@ func resourceDeploymentProcessUpdate(ctx context.Context, d *schema.ResourceData
 	return nil
 }
 
+// updateDeploymentProcess writes a deployment process. When the project is stored in version control, the change is
+// committed to the branch of the deployment process and the resulting commit is recorded.
+func updateDeploymentProcess(d *schema.ResourceData, client *client.Client, project *projects.Project, deploymentProcess *deployments.DeploymentProcess) (*deployments.DeploymentProcess, error) {
+	gitPersistenceSettings := getGitPersistenceSettings(project)
+	if gitPersistenceSettings == nil {
+		return client.DeploymentProcesses.Update(deploymentProcess)
+	}
+
+	if err := validateGitRefCommit(d, gitPersistenceSettings, deploymentProcess.Branch); err != nil {
+		return nil, err
+	}
+
+	updatedDeploymentProcess := new(deployments.DeploymentProcess)
+	if err := commitVersionControlledResource(client, deploymentProcess.Links["Self"], deploymentProcess, getGitCommitMessage(d, "deployment process"), updatedDeploymentProcess); err != nil {
+		return nil, err
+	}
+	updatedDeploymentProcess.Branch = deploymentProcess.Branch
+
+	gitCommit, err := getGitCommit(client, project.SpaceID, project.GetID(), deploymentProcess.Branch)
+	if err != nil {
+		log.Printf("[WARN] unable to determine the commit for deployment process (%s): %s", deploymentProcess.GetID(), err)
+	}
+	d.Set("git_commit", gitCommit)
+
+	return updatedDeploymentProcess, nil
+}
+
 func getGitRef(d *schema.ResourceData) string {
 	r, _ := regexp.Compile(`\d+-\w+`)
 	parts := strings.SplitAfter(r.FindString(d.Id()), "-")
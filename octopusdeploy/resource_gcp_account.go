@@ -14,16 +14,21 @@ import (
 func resourceGoogleCloudPlatformAccount() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceGoogleCloudPlatformAccountCreate,
+		CustomizeDiff: customizeTenantTagsDiff,
 		DeleteContext: resourceGoogleCloudPlatformAccountDelete,
 		Description:   "This resource manages GCP accounts in Octopus Deploy.",
 		Importer:      getImporter(),
 		ReadContext:   resourceGoogleCloudPlatformAccountRead,
-		Schema:        getGoogleCloudPlatformAccountSchema(),
+		Schema:        addTenantTagSchema(getGoogleCloudPlatformAccountSchema()),
 		UpdateContext: resourceGoogleCloudPlatformAccountUpdate,
 	}
 }
 
 func resourceGoogleCloudPlatformAccountCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateResourceTenantTags(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
 	account := expandGoogleCloudPlatformAccount(d)
 
 	log.Printf("[INFO] creating GCP account: %#v", account)
@@ -77,6 +82,10 @@ func resourceGoogleCloudPlatformAccountRead(ctx context.Context, d *schema.Resou
 }
 
 func resourceGoogleCloudPlatformAccountUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateResourceTenantTags(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
 	account := expandGoogleCloudPlatformAccount(d)
 
 	log.Printf("[INFO] updating GCP account: %#v", account)
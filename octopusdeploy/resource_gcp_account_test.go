@@ -26,7 +26,7 @@ func TestAccGcpAccountBasic(t *testing.T) {
 			{
 				Check: resource.ComposeTestCheckFunc(
 					testAccountExists(prefix),
-					resource.TestCheckResourceAttr(prefix, "json_key", jsonKey),
+					resource.TestCheckResourceAttr(prefix, "json_key", hashAccountSecret(jsonKey)),
 					resource.TestCheckResourceAttr(prefix, "description", description),
 					resource.TestCheckResourceAttr(prefix, "name", name),
 					resource.TestCheckResourceAttr(prefix, "tenanted_deployment_participation", string(tenantedDeploymentParticipation)),
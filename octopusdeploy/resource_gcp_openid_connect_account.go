@@ -0,0 +1,104 @@
+package octopusdeploy
+
+import (
+	"context"
+	"log"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/oidcaccounts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGoogleCloudPlatformOpenIDConnectAccount() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGoogleCloudPlatformOpenIDConnectAccountCreate,
+		CustomizeDiff: customizeTenantTagsDiff,
+		DeleteContext: resourceGoogleCloudPlatformOpenIDConnectAccountDelete,
+		Description:   "This resource manages GCP accounts that authenticate with workload identity federation in Octopus Deploy.",
+		Importer:      getImporter(),
+		ReadContext:   resourceGoogleCloudPlatformOpenIDConnectAccountRead,
+		Schema:        addTenantTagSchema(getGoogleCloudPlatformOpenIDConnectAccountSchema()),
+		UpdateContext: resourceGoogleCloudPlatformOpenIDConnectAccountUpdate,
+	}
+}
+
+func resourceGoogleCloudPlatformOpenIDConnectAccountCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateResourceTenantTags(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
+	account := expandGoogleCloudPlatformOpenIDConnectAccount(d)
+
+	log.Printf("[INFO] creating GCP OpenID Connect account: %#v", account)
+
+	client := m.(*client.Client)
+	createdAccount, err := oidcaccounts.Add(client, account.SpaceID, account)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setGoogleCloudPlatformOpenIDConnectAccount(ctx, d, createdAccount); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(createdAccount.GetID())
+
+	log.Printf("[INFO] GCP OpenID Connect account created (%s)", d.Id())
+	return nil
+}
+
+func resourceGoogleCloudPlatformOpenIDConnectAccountDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] deleting GCP OpenID Connect account (%s)", d.Id())
+
+	client := m.(*client.Client)
+	if err := oidcaccounts.DeleteByID(client, d.Get("space_id").(string), d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] GCP OpenID Connect account deleted")
+	return nil
+}
+
+func resourceGoogleCloudPlatformOpenIDConnectAccountRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading GCP OpenID Connect account (%s)", d.Id())
+
+	client := m.(*client.Client)
+	account, err := oidcaccounts.GetByID[oidcaccounts.GoogleCloudOIDCAccount](client, d.Get("space_id").(string), d.Id())
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "GCP OpenID Connect account")
+	}
+
+	if err := setGoogleCloudPlatformOpenIDConnectAccount(ctx, d, account); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] GCP OpenID Connect account read (%s)", d.Id())
+	return nil
+}
+
+func resourceGoogleCloudPlatformOpenIDConnectAccountUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateResourceTenantTags(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
+	account := expandGoogleCloudPlatformOpenIDConnectAccount(d)
+
+	log.Printf("[INFO] updating GCP OpenID Connect account: %#v", account)
+
+	client := m.(*client.Client)
+	updatedAccount, err := oidcaccounts.Update(client, account.SpaceID, d.Id(), account)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setGoogleCloudPlatformOpenIDConnectAccount(ctx, d, updatedAccount); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] GCP OpenID Connect account updated (%s)", d.Id())
+	return nil
+}
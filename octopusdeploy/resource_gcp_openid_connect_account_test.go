@@ -0,0 +1,40 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccGcpOpenIDConnectAccountBasic(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_gcp_openid_connect_account." + localName
+
+	audience := "//iam.googleapis.com/projects/123456789012/locations/global/workloadIdentityPools/octopus/providers/" + acctest.RandStringFromCharSet(20, acctest.CharSetAlphaNum)
+	name := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testOpenIDConnectAccountCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testOpenIDConnectAccountExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "audience", audience),
+					resource.TestCheckResourceAttr(prefix, "name", name),
+				),
+				Config: testGcpOpenIDConnectAccountBasic(localName, name, audience),
+			},
+		},
+	})
+}
+
+func testGcpOpenIDConnectAccountBasic(localName string, name string, audience string) string {
+	return fmt.Sprintf(`resource "octopusdeploy_gcp_openid_connect_account" "%s" {
+		audience = "%s"
+		name     = "%s"
+	}`, localName, audience, name)
+}
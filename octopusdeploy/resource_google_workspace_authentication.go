@@ -0,0 +1,55 @@
+package octopusdeploy
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/authentication"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var googleWorkspaceAuthenticationProvider = &authenticationProvider{
+	configurationID: authentication.GoogleAppsConfigurationID,
+	expand: func(d *schema.ResourceData) interface{} {
+		return &authentication.GoogleAppsConfiguration{
+			AllowAutoUserCreation: d.Get("allow_auto_user_creation").(bool),
+			ClientID:              d.Get("client_id").(string),
+			ClientSecret:          expandChangedSensitiveValue(d, "client_secret"),
+			HostedDomain:          d.Get("hosted_domain").(string),
+			IsEnabled:             d.Get("is_enabled").(bool),
+		}
+	},
+	name: "Google Workspace",
+	read: func(ctx context.Context, d *schema.ResourceData, client *client.Client) error {
+		configuration, err := authentication.GetConfiguration[authentication.GoogleAppsConfiguration](client, authentication.GoogleAppsConfigurationID)
+		if err != nil {
+			return err
+		}
+
+		d.Set("allow_auto_user_creation", configuration.AllowAutoUserCreation)
+		d.Set("client_id", configuration.ClientID)
+		d.Set("hosted_domain", configuration.HostedDomain)
+		d.Set("is_enabled", configuration.IsEnabled)
+		return nil
+	},
+}
+
+func resourceGoogleWorkspaceAuthentication() *schema.Resource {
+	// Google Workspace uses a fixed issuer and does not support role claims
+	googleWorkspaceSchema := getOpenIDConnectSettingsSchema("Google Workspace")
+	delete(googleWorkspaceSchema, "issuer")
+	delete(googleWorkspaceSchema, "role_claim_type")
+
+	googleWorkspaceSchema["hosted_domain"] = &schema.Schema{
+		Description:      "The Google Workspace domain (i.e. `example.com`) whose users can sign in.",
+		Required:         true,
+		Type:             schema.TypeString,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+	}
+
+	return googleWorkspaceAuthenticationProvider.resource(
+		"This resource manages the Google Workspace authentication provider of the Octopus Server. Destroying this resource removes it from the state and leaves the provider as it is, unless `disable_on_destroy` is set.",
+		googleWorkspaceSchema,
+	)
+}
@@ -0,0 +1,104 @@
+package octopusdeploy
+
+import (
+	"context"
+	"log"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/insights"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceInsightsReport() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceInsightsReportCreate,
+		DeleteContext: resourceInsightsReportDelete,
+		Description:   "This resource manages Octopus Insights reports, which calculate DORA metrics (deployment frequency, lead time, change failure rate and time to recovery) for a selection of projects, environments and tenants.",
+		Importer:      getImporter(),
+		ReadContext:   resourceInsightsReportRead,
+		Schema:        getInsightsReportSchema(),
+		UpdateContext: resourceInsightsReportUpdate,
+	}
+}
+
+func resourceInsightsReportCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	insightsReport := expandInsightsReport(d)
+
+	log.Printf("[INFO] creating insights report: %#v", insightsReport)
+
+	client := m.(*client.Client)
+	createdInsightsReport, err := insights.Add(client, insightsReport)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setInsightsReport(ctx, d, createdInsightsReport); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(createdInsightsReport.GetID())
+
+	log.Printf("[INFO] insights report created (%s)", d.Id())
+	return nil
+}
+
+func resourceInsightsReportDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] deleting insights report (%s)", d.Id())
+
+	var spaceID string
+	if v, ok := d.GetOk("space_id"); ok {
+		spaceID = v.(string)
+	}
+
+	client := m.(*client.Client)
+	if err := insights.DeleteByID(client, spaceID, d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] insights report deleted (%s)", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func resourceInsightsReportRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading insights report (%s)", d.Id())
+
+	var spaceID string
+	if v, ok := d.GetOk("space_id"); ok {
+		spaceID = v.(string)
+	}
+
+	client := m.(*client.Client)
+	insightsReport, err := insights.GetByID(client, spaceID, d.Id())
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "insights report")
+	}
+
+	if err := setInsightsReport(ctx, d, insightsReport); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] insights report read (%s)", d.Id())
+	return nil
+}
+
+func resourceInsightsReportUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] updating insights report (%s)", d.Id())
+
+	insightsReport := expandInsightsReport(d)
+
+	client := m.(*client.Client)
+	updatedInsightsReport, err := insights.Update(client, insightsReport)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setInsightsReport(ctx, d, updatedInsightsReport); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] insights report updated (%s)", d.Id())
+	return nil
+}
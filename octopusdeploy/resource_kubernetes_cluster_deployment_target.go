@@ -8,22 +8,29 @@ import (
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/machines"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceKubernetesClusterDeploymentTarget() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceKubernetesClusterDeploymentTargetCreate,
+		CustomizeDiff: customdiff.All(validateProxyIDDiff, customizeTenantTagsDiff, validateWaitForHealthDiff),
 		DeleteContext: resourceKubernetesClusterDeploymentTargetDelete,
 		Description:   "This resource manages Kubernetes cluster deployment targets in Octopus Deploy.",
 		Importer:      getImporter(),
 		ReadContext:   resourceKubernetesClusterDeploymentTargetRead,
-		Schema:        getKubernetesClusterDeploymentTargetSchema(),
+		Schema:        addTenantTagSchema(addWaitForHealthSchema(getKubernetesClusterDeploymentTargetSchema())),
+		Timeouts:      getWaitForHealthTimeouts(),
 		UpdateContext: resourceKubernetesClusterDeploymentTargetUpdate,
 	}
 }
 
 func resourceKubernetesClusterDeploymentTargetCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateResourceTenantTags(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
 	deploymentTarget := expandKubernetesClusterDeploymentTarget(d)
 
 	log.Printf("[INFO] creating Kubernetes cluster deployment target: %#v", deploymentTarget)
@@ -41,7 +48,7 @@ func resourceKubernetesClusterDeploymentTargetCreate(ctx context.Context, d *sch
 	d.SetId(createdDeploymentTarget.GetID())
 
 	log.Printf("[INFO] Kubernetes cluster deployment target created (%s)", d.Id())
-	return nil
+	return waitForDeploymentTargetHealth(ctx, d, client)
 }
 
 func resourceKubernetesClusterDeploymentTargetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -76,6 +83,10 @@ func resourceKubernetesClusterDeploymentTargetRead(ctx context.Context, d *schem
 }
 
 func resourceKubernetesClusterDeploymentTargetUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateResourceTenantTags(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
 	log.Printf("[INFO] updating Kubernetes cluster deployment target (%s)", d.Id())
 
 	deploymentTarget := expandKubernetesClusterDeploymentTarget(d)
@@ -90,5 +101,5 @@ func resourceKubernetesClusterDeploymentTargetUpdate(ctx context.Context, d *sch
 	}
 
 	log.Printf("[INFO] Kubernetes cluster deployment target updated (%s)", d.Id())
-	return nil
+	return waitForDeploymentTargetHealth(ctx, d, client)
 }
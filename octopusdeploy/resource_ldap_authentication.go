@@ -0,0 +1,146 @@
+package octopusdeploy
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/authentication"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var ldapAuthenticationProvider = &authenticationProvider{
+	configurationID: authentication.LDAPConfigurationID,
+	expand: func(d *schema.ResourceData) interface{} {
+		return &authentication.LDAPConfiguration{
+			AllowAutoUserCreation:      d.Get("allow_auto_user_creation").(bool),
+			BaseDN:                     d.Get("base_dn").(string),
+			ConnectPassword:            expandChangedSensitiveValue(d, "connect_password"),
+			ConnectUsername:            d.Get("connect_username").(string),
+			DefaultDomain:              d.Get("default_domain").(string),
+			GroupFilter:                d.Get("group_filter").(string),
+			IgnoreSSLErrors:            d.Get("ignore_ssl_errors").(bool),
+			IsEnabled:                  d.Get("is_enabled").(bool),
+			NestedGroupFilter:          d.Get("nested_group_filter").(string),
+			NestedGroupSearchDepth:     d.Get("nested_group_search_depth").(int),
+			Port:                       d.Get("port").(int),
+			SecurityProtocol:           d.Get("security_protocol").(string),
+			Server:                     d.Get("server").(string),
+			UniqueAccountNameAttribute: d.Get("unique_account_name_attribute").(string),
+			UserFilter:                 d.Get("user_filter").(string),
+		}
+	},
+	name: "LDAP",
+	read: func(ctx context.Context, d *schema.ResourceData, client *client.Client) error {
+		configuration, err := authentication.GetConfiguration[authentication.LDAPConfiguration](client, authentication.LDAPConfigurationID)
+		if err != nil {
+			return err
+		}
+
+		d.Set("allow_auto_user_creation", configuration.AllowAutoUserCreation)
+		d.Set("base_dn", configuration.BaseDN)
+		d.Set("connect_username", configuration.ConnectUsername)
+		d.Set("default_domain", configuration.DefaultDomain)
+		d.Set("group_filter", configuration.GroupFilter)
+		d.Set("ignore_ssl_errors", configuration.IgnoreSSLErrors)
+		d.Set("is_enabled", configuration.IsEnabled)
+		d.Set("nested_group_filter", configuration.NestedGroupFilter)
+		d.Set("nested_group_search_depth", configuration.NestedGroupSearchDepth)
+		d.Set("port", configuration.Port)
+		d.Set("security_protocol", configuration.SecurityProtocol)
+		d.Set("server", configuration.Server)
+		d.Set("unique_account_name_attribute", configuration.UniqueAccountNameAttribute)
+		d.Set("user_filter", configuration.UserFilter)
+		return nil
+	},
+}
+
+func resourceLDAPAuthentication() *schema.Resource {
+	return ldapAuthenticationProvider.resource(
+		"This resource manages the LDAP authentication provider of the Octopus Server. Destroying this resource removes it from the state and leaves the provider as it is, unless `disable_on_destroy` is set.",
+		map[string]*schema.Schema{
+			"allow_auto_user_creation": getAllowAutoUserCreationSchema("LDAP"),
+			"base_dn": {
+				Description:      "The distinguished name of the root of the directory tree that is searched for users and groups (i.e. `DC=example,DC=com`).",
+				Required:         true,
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+			},
+			"connect_password": {
+				Description: "The password of the account used to query the LDAP server. The server never returns the password, so changes made outside of Terraform are not detected.",
+				Optional:    true,
+				Sensitive:   true,
+				Type:        schema.TypeString,
+			},
+			"connect_username": {
+				Description: "The distinguished name of the account used to query the LDAP server.",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"default_domain": {
+				Computed:    true,
+				Description: "The domain that is assumed when users sign in without specifying one.",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"group_filter": {
+				Computed:    true,
+				Description: "The filter used to search for groups (i.e. `(&(objectClass=group)(cn=*))`).",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"ignore_ssl_errors": {
+				Default:     false,
+				Description: "Indicates whether certificate errors are ignored when connecting to the LDAP server.",
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
+			"is_enabled": getAuthenticationProviderIsEnabledSchema("LDAP"),
+			"nested_group_filter": {
+				Computed:    true,
+				Description: "The filter used to search for the groups that a group is a member of.",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"nested_group_search_depth": {
+				Default:          5,
+				Description:      "The number of levels of nested groups that are searched. Set to `0` to disable searching nested groups.",
+				Optional:         true,
+				Type:             schema.TypeInt,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+			},
+			"port": {
+				Default:          389,
+				Description:      "The port of the LDAP server.",
+				Optional:         true,
+				Type:             schema.TypeInt,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsPortNumber),
+			},
+			"security_protocol": {
+				Default:          "None",
+				Description:      "The protocol used to secure the connection to the LDAP server. Valid protocols are `None`, `SSL`, or `StartTLS`.",
+				Optional:         true,
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"None", "SSL", "StartTLS"}, false)),
+			},
+			"server": {
+				Description:      "The host name of the LDAP server.",
+				Required:         true,
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+			},
+			"unique_account_name_attribute": {
+				Computed:    true,
+				Description: "The attribute that uniquely identifies an account (i.e. `sAMAccountName`).",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"user_filter": {
+				Computed:    true,
+				Description: "The filter used to search for users (i.e. `(&(objectClass=person)(sAMAccountName=*))`).",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+		},
+	)
+}
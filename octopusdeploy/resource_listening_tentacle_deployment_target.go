@@ -8,22 +8,29 @@ import (
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/machines"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceListeningTentacleDeploymentTarget() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceListeningTentacleDeploymentTargetCreate,
+		CustomizeDiff: customdiff.All(validateProxyIDDiff, customizeTenantTagsDiff, validateWaitForHealthDiff),
 		DeleteContext: resourceListeningTentacleDeploymentTargetDelete,
 		Description:   "This resource manages listening tentacle deployment targets in Octopus Deploy.",
 		Importer:      getImporter(),
 		ReadContext:   resourceListeningTentacleDeploymentTargetRead,
-		Schema:        getListeningTentacleDeploymentTargetSchema(),
+		Schema:        addTenantTagSchema(addWaitForHealthSchema(getListeningTentacleDeploymentTargetSchema())),
+		Timeouts:      getWaitForHealthTimeouts(),
 		UpdateContext: resourceListeningTentacleDeploymentTargetUpdate,
 	}
 }
 
 func resourceListeningTentacleDeploymentTargetCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateResourceTenantTags(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
 	deploymentTarget := expandListeningTentacleDeploymentTarget(d)
 
 	log.Printf("[INFO] creating listening tentacle deployment target: %#v", deploymentTarget)
@@ -41,7 +48,7 @@ func resourceListeningTentacleDeploymentTargetCreate(ctx context.Context, d *sch
 	d.SetId(createdDeploymentTarget.GetID())
 
 	log.Printf("[INFO] listening tentacle deployment target created (%s)", d.Id())
-	return nil
+	return waitForDeploymentTargetHealth(ctx, d, client)
 }
 
 func resourceListeningTentacleDeploymentTargetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -76,6 +83,10 @@ func resourceListeningTentacleDeploymentTargetRead(ctx context.Context, d *schem
 }
 
 func resourceListeningTentacleDeploymentTargetUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateResourceTenantTags(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
 	log.Printf("[INFO] updating listening tentacle deployment target (%s)", d.Id())
 
 	deploymentTarget := expandListeningTentacleDeploymentTarget(d)
@@ -90,5 +101,5 @@ func resourceListeningTentacleDeploymentTargetUpdate(ctx context.Context, d *sch
 	}
 
 	log.Printf("[INFO] listening tentacle deployment target updated (%s)", d.Id())
-	return nil
+	return waitForDeploymentTargetHealth(ctx, d, client)
 }
@@ -0,0 +1,152 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/proxies"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/machineproxies"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceMachineProxy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMachineProxyCreate,
+		DeleteContext: resourceMachineProxyDelete,
+		Description:   "This resource manages machine proxies in Octopus Deploy. Listening tentacles, SSH connections and Kubernetes clusters connect through a machine proxy when their `proxy_id` is set to its ID.",
+		Importer:      getImporter(),
+		ReadContext:   resourceMachineProxyRead,
+		Schema:        getMachineProxySchema(),
+		UpdateContext: resourceMachineProxyUpdate,
+	}
+}
+
+func resourceMachineProxyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	proxy := expandMachineProxy(d)
+
+	log.Printf("[INFO] creating machine proxy: %s", proxy.Name)
+
+	client := m.(*client.Client)
+	createdProxy, err := machineproxies.Add(client, proxy)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setMachineProxy(ctx, d, createdProxy); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(createdProxy.GetID())
+
+	log.Printf("[INFO] machine proxy created (%s)", d.Id())
+	return nil
+}
+
+func resourceMachineProxyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] deleting machine proxy (%s)", d.Id())
+
+	client := m.(*client.Client)
+	if err := machineproxies.DeleteByID(client, d.Get("space_id").(string), d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] machine proxy deleted (%s)", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func resourceMachineProxyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading machine proxy (%s)", d.Id())
+
+	client := m.(*client.Client)
+	proxy, err := machineproxies.GetByID(client, d.Get("space_id").(string), d.Id())
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "machine proxy")
+	}
+
+	if err := setMachineProxy(ctx, d, proxy); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] machine proxy read (%s)", d.Id())
+	return nil
+}
+
+func resourceMachineProxyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] updating machine proxy (%s)", d.Id())
+
+	proxy := expandMachineProxy(d)
+	client := m.(*client.Client)
+	updatedProxy, err := machineproxies.Update(client, proxy)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setMachineProxy(ctx, d, updatedProxy); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] machine proxy updated (%s)", d.Id())
+	return nil
+}
+
+// validateProxyIDDiff fails the plan of a deployment target whose proxy_id does not match a machine proxy of its space.
+// The proxy is only looked up when proxy_id is known and has changed, which includes the plan that creates the
+// deployment target.
+func validateProxyIDDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	client, ok := m.(*client.Client)
+	if !ok || client == nil || !d.NewValueKnown("proxy_id") || !d.HasChange("proxy_id") {
+		return nil
+	}
+
+	proxyID := d.Get("proxy_id").(string)
+	if len(proxyID) == 0 {
+		return nil
+	}
+
+	existingProxies, err := getMachineProxies(ctx, client, d.Get("space_id").(string), &proxies.ProxiesQuery{})
+	if err != nil {
+		return err
+	}
+
+	return checkProxyID(proxyID, existingProxies)
+}
+
+// checkProxyID returns an error that lists the available machine proxies when none of them has the input ID.
+func checkProxyID(proxyID string, existingProxies []*proxies.Proxy) error {
+	descriptions := []string{}
+	for _, proxy := range existingProxies {
+		if proxy.GetID() == proxyID {
+			return nil
+		}
+
+		descriptions = append(descriptions, fmt.Sprintf("%q (%s)", proxy.Name, proxy.GetID()))
+	}
+
+	if len(descriptions) == 0 {
+		return fmt.Errorf("proxy_id: no machine proxy with ID '%s' was found; the space has no machine proxies", proxyID)
+	}
+
+	sort.Strings(descriptions)
+	return fmt.Errorf("proxy_id: no machine proxy with ID '%s' was found; available machine proxies are %s", proxyID, strings.Join(descriptions, ", "))
+}
+
+func getMachineProxies(ctx context.Context, client *client.Client, spaceID string, query *proxies.ProxiesQuery) ([]*proxies.Proxy, error) {
+	page, err := machineproxies.Get(client, spaceID, query)
+	if err != nil {
+		return nil, err
+	}
+
+	nextProxies, err := getNextPages[*proxies.Proxy](ctx, client, page.PagedResults)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(page.Items, nextProxies...), nil
+}
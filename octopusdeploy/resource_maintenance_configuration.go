@@ -0,0 +1,78 @@
+package octopusdeploy
+
+import (
+	"context"
+	"log"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/serverconfiguration"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceMaintenanceConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMaintenanceConfigurationUpdate,
+		DeleteContext: resourceMaintenanceConfigurationDelete,
+		Description:   "This resource manages the maintenance mode of the Octopus Deploy server. In maintenance mode, only system administrators can sign in and no new tasks are started. Destroying this resource takes the server out of maintenance mode.",
+		Importer:      getImporter(),
+		ReadContext:   resourceMaintenanceConfigurationRead,
+		Schema: map[string]*schema.Schema{
+			"id": getIDSchema(),
+			"is_in_maintenance_mode": {
+				Description: "Indicates whether the server is in maintenance mode.",
+				Required:    true,
+				Type:        schema.TypeBool,
+			},
+		},
+		UpdateContext: resourceMaintenanceConfigurationUpdate,
+	}
+}
+
+func resourceMaintenanceConfigurationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] restoring default maintenance configuration (%s)", d.Id())
+
+	client := m.(*client.Client)
+	if err := serverconfiguration.UpdateMaintenanceConfiguration(client, &serverconfiguration.MaintenanceConfiguration{IsInMaintenanceMode: false}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] default maintenance configuration restored")
+	return nil
+}
+
+func resourceMaintenanceConfigurationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading maintenance configuration (%s)", d.Id())
+
+	client := m.(*client.Client)
+	configuration, err := serverconfiguration.GetMaintenanceConfiguration(client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("is_in_maintenance_mode", configuration.IsInMaintenanceMode)
+	d.SetId(serverconfiguration.MaintenanceConfigurationID)
+
+	log.Printf("[INFO] maintenance configuration read (%s)", d.Id())
+	return nil
+}
+
+func resourceMaintenanceConfigurationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	configuration := &serverconfiguration.MaintenanceConfiguration{
+		IsInMaintenanceMode: d.Get("is_in_maintenance_mode").(bool),
+	}
+
+	log.Printf("[INFO] updating maintenance configuration (%s)", serverconfiguration.MaintenanceConfigurationID)
+
+	client := m.(*client.Client)
+	if err := serverconfiguration.UpdateMaintenanceConfiguration(client, configuration); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(serverconfiguration.MaintenanceConfigurationID)
+
+	log.Printf("[INFO] maintenance configuration updated (%s)", d.Id())
+	return resourceMaintenanceConfigurationRead(ctx, d, m)
+}
@@ -8,22 +8,29 @@ import (
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/machines"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceOfflinePackageDropDeploymentTarget() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceOfflinePackageDropDeploymentTargetCreate,
+		CustomizeDiff: customdiff.All(customizeTenantTagsDiff, validateWaitForHealthDiff),
 		DeleteContext: resourceOfflinePackageDropDeploymentTargetDelete,
 		Description:   "This resource manages offline package drop deployment targets in Octopus Deploy.",
 		Importer:      getImporter(),
 		ReadContext:   resourceOfflinePackageDropDeploymentTargetRead,
-		Schema:        getOfflinePackageDropDeploymentTargetSchema(),
+		Schema:        addTenantTagSchema(addWaitForHealthSchema(getOfflinePackageDropDeploymentTargetSchema())),
+		Timeouts:      getWaitForHealthTimeouts(),
 		UpdateContext: resourceOfflinePackageDropDeploymentTargetUpdate,
 	}
 }
 
 func resourceOfflinePackageDropDeploymentTargetCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateResourceTenantTags(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
 	deploymentTarget := expandOfflinePackageDropDeploymentTarget(d)
 
 	log.Printf("[INFO] creating offline package drop deployment target: %#v", deploymentTarget)
@@ -41,7 +48,7 @@ func resourceOfflinePackageDropDeploymentTargetCreate(ctx context.Context, d *sc
 	d.SetId(createdDeploymentTarget.GetID())
 
 	log.Printf("[INFO] offline package drop deployment target created (%s)", d.Id())
-	return nil
+	return waitForDeploymentTargetHealth(ctx, d, client)
 }
 
 func resourceOfflinePackageDropDeploymentTargetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -76,6 +83,10 @@ func resourceOfflinePackageDropDeploymentTargetRead(ctx context.Context, d *sche
 }
 
 func resourceOfflinePackageDropDeploymentTargetUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateResourceTenantTags(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
 	log.Printf("[INFO] updating offline package drop deployment target (%s)", d.Id())
 
 	deploymentTarget := expandOfflinePackageDropDeploymentTarget(d)
@@ -90,5 +101,5 @@ func resourceOfflinePackageDropDeploymentTargetUpdate(ctx context.Context, d *sc
 	}
 
 	log.Printf("[INFO] offline package drop deployment target updated (%s)", d.Id())
-	return nil
+	return waitForDeploymentTargetHealth(ctx, d, client)
 }
@@ -0,0 +1,45 @@
+package octopusdeploy
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/authentication"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var oktaAuthenticationProvider = &authenticationProvider{
+	configurationID: authentication.OktaConfigurationID,
+	expand: func(d *schema.ResourceData) interface{} {
+		return &authentication.OktaConfiguration{
+			OpenIDConnectSettings: expandOpenIDConnectSettings(d),
+			UsernameClaimType:     d.Get("username_claim_type").(string),
+		}
+	},
+	name: "Okta",
+	read: func(ctx context.Context, d *schema.ResourceData, client *client.Client) error {
+		configuration, err := authentication.GetConfiguration[authentication.OktaConfiguration](client, authentication.OktaConfigurationID)
+		if err != nil {
+			return err
+		}
+
+		setOpenIDConnectSettings(d, configuration.OpenIDConnectSettings)
+		d.Set("username_claim_type", configuration.UsernameClaimType)
+		return nil
+	},
+}
+
+func resourceOktaAuthentication() *schema.Resource {
+	oktaSchema := getOpenIDConnectSettingsSchema("Okta")
+	oktaSchema["username_claim_type"] = &schema.Schema{
+		Computed:    true,
+		Description: "The type of the claim that contains the username of a user.",
+		Optional:    true,
+		Type:        schema.TypeString,
+	}
+
+	return oktaAuthenticationProvider.resource(
+		"This resource manages the Okta authentication provider of the Octopus Server. Destroying this resource removes it from the state and leaves the provider as it is, unless `disable_on_destroy` is set.",
+		oktaSchema,
+	)
+}
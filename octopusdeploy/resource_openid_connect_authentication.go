@@ -0,0 +1,61 @@
+package octopusdeploy
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/authentication"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var openIDConnectAuthenticationProvider = &authenticationProvider{
+	configurationID: authentication.OpenIDConnectConfigurationID,
+	expand: func(d *schema.ResourceData) interface{} {
+		return &authentication.OpenIDConnectConfiguration{
+			NameClaimType:         d.Get("name_claim_type").(string),
+			OpenIDConnectSettings: expandOpenIDConnectSettings(d),
+			Scope:                 d.Get("scope").(string),
+			UsernameClaimType:     d.Get("username_claim_type").(string),
+		}
+	},
+	name: "OpenID Connect",
+	read: func(ctx context.Context, d *schema.ResourceData, client *client.Client) error {
+		configuration, err := authentication.GetConfiguration[authentication.OpenIDConnectConfiguration](client, authentication.OpenIDConnectConfigurationID)
+		if err != nil {
+			return err
+		}
+
+		setOpenIDConnectSettings(d, configuration.OpenIDConnectSettings)
+		d.Set("name_claim_type", configuration.NameClaimType)
+		d.Set("scope", configuration.Scope)
+		d.Set("username_claim_type", configuration.UsernameClaimType)
+		return nil
+	},
+}
+
+func resourceOpenIDConnectAuthentication() *schema.Resource {
+	openIDConnectSchema := getOpenIDConnectSettingsSchema("the OpenID Connect provider")
+	openIDConnectSchema["name_claim_type"] = &schema.Schema{
+		Computed:    true,
+		Description: "The type of the claim that contains the display name of a user.",
+		Optional:    true,
+		Type:        schema.TypeString,
+	}
+	openIDConnectSchema["scope"] = &schema.Schema{
+		Computed:    true,
+		Description: "The scopes requested from the OpenID Connect provider (i.e. `openid profile email`).",
+		Optional:    true,
+		Type:        schema.TypeString,
+	}
+	openIDConnectSchema["username_claim_type"] = &schema.Schema{
+		Computed:    true,
+		Description: "The type of the claim that contains the username of a user.",
+		Optional:    true,
+		Type:        schema.TypeString,
+	}
+
+	return openIDConnectAuthenticationProvider.resource(
+		"This resource manages the generic OpenID Connect authentication provider of the Octopus Server. Destroying this resource removes it from the state and leaves the provider as it is, unless `disable_on_destroy` is set.",
+		openIDConnectSchema,
+	)
+}
@@ -8,22 +8,29 @@ import (
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/machines"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourcePollingTentacleDeploymentTarget() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourcePollingTentacleDeploymentTargetCreate,
+		CustomizeDiff: customdiff.All(customizeTenantTagsDiff, validateWaitForHealthDiff),
 		DeleteContext: resourcePollingTentacleDeploymentTargetDelete,
 		Description:   "This resource manages polling tentacle deployment targets in Octopus Deploy.",
 		Importer:      getImporter(),
 		ReadContext:   resourcePollingTentacleDeploymentTargetRead,
-		Schema:        getPollingTentacleDeploymentTargetSchema(),
+		Schema:        addTenantTagSchema(addWaitForHealthSchema(getPollingTentacleDeploymentTargetSchema())),
+		Timeouts:      getWaitForHealthTimeouts(),
 		UpdateContext: resourcePollingTentacleDeploymentTargetUpdate,
 	}
 }
 
 func resourcePollingTentacleDeploymentTargetCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateResourceTenantTags(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
 	deploymentTarget := expandPollingTentacleDeploymentTarget(d)
 
 	log.Printf("[INFO] creating polling tentacle deployment target: %#v", deploymentTarget)
@@ -41,7 +48,7 @@ func resourcePollingTentacleDeploymentTargetCreate(ctx context.Context, d *schem
 	d.SetId(createdDeploymentTarget.GetID())
 
 	log.Printf("[INFO] polling tentacle deployment target created (%s)", d.Id())
-	return nil
+	return waitForDeploymentTargetHealth(ctx, d, client)
 }
 
 func resourcePollingTentacleDeploymentTargetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -76,6 +83,10 @@ func resourcePollingTentacleDeploymentTargetRead(ctx context.Context, d *schema.
 }
 
 func resourcePollingTentacleDeploymentTargetUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateResourceTenantTags(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
 	log.Printf("[INFO] updating polling tentacle deployment target (%s)", d.Id())
 
 	deploymentTarget := expandPollingTentacleDeploymentTarget(d)
@@ -90,5 +101,5 @@ func resourcePollingTentacleDeploymentTargetUpdate(ctx context.Context, d *schem
 	}
 
 	log.Printf("[INFO] polling tentacle deployment target updated (%s)", d.Id())
-	return nil
+	return waitForDeploymentTargetHealth(ctx, d, client)
 }
@@ -0,0 +1,208 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceProcessStep() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceProcessStepCreate,
+		DeleteContext: resourceProcessStepDelete,
+		Description:   "This resource manages a single step within a deployment process or runbook process in Octopus Deploy. Other steps in the process are left untouched, so several configurations may each contribute steps to the same process. Do not use this resource together with `octopusdeploy_deployment_process` or `octopusdeploy_runbook_process` for the same process.",
+		Importer:      &schema.ResourceImporter{StateContext: resourceProcessStepImporter},
+		ReadContext:   resourceProcessStepRead,
+		Schema:        getProcessStepSchema(),
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(5 * time.Minute),
+		},
+		UpdateContext: resourceProcessStepUpdate,
+	}
+}
+
+func getProcessStepSchema() map[string]*schema.Schema {
+	stepSchema := map[string]*schema.Schema{}
+	for key, value := range getDeploymentStepSchema().Elem.(*schema.Resource).Schema {
+		if key != "id" {
+			stepSchema[key] = value
+		}
+	}
+
+	stepSchema["process_id"] = &schema.Schema{
+		Description: "The ID of the deployment process (i.e. `deploymentprocess-Projects-123`) or runbook process (i.e. `RunbookProcess-Runbooks-123`) that contains this step.",
+		ForceNew:    true,
+		Required:    true,
+		Type:        schema.TypeString,
+	}
+	stepSchema["space_id"] = getSpaceIDSchema()
+	stepSchema["step_id"] = &schema.Schema{
+		Computed:    true,
+		Description: "The ID of this step within the process.",
+		Type:        schema.TypeString,
+	}
+
+	return stepSchema
+}
+
+func expandProcessStep(ctx context.Context, d *schema.ResourceData) *deployments.DeploymentStep {
+	flattenedStep := map[string]interface{}{}
+	for key := range getDeploymentStepSchema().Elem.(*schema.Resource).Schema {
+		if key != "id" {
+			flattenedStep[key] = d.Get(key)
+		}
+	}
+
+	step := expandDeploymentStep(ctx, flattenedStep)
+	step.ID = d.Get("step_id").(string)
+	return step
+}
+
+func setProcessStep(ctx context.Context, d *schema.ResourceData, processID string, step *deployments.DeploymentStep) error {
+	flattenedStep := flattenDeploymentSteps([]*deployments.DeploymentStep{step})[0]
+
+	for key := range getDeploymentStepSchema().Elem.(*schema.Resource).Schema {
+		if key == "id" {
+			continue
+		}
+
+		if err := d.Set(key, flattenedStep[key]); err != nil {
+			return fmt.Errorf("error setting %s: %s", key, err)
+		}
+	}
+
+	d.Set("process_id", processID)
+	d.Set("step_id", step.ID)
+
+	return nil
+}
+
+func resourceProcessStepCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	processID := d.Get("process_id").(string)
+	spaceID := d.Get("space_id").(string)
+	step := expandProcessStep(ctx, d)
+
+	log.Printf("[INFO] creating process step: %#v", step)
+
+	client := m.(*client.Client)
+	updatedProcess, err := updateProcessSteps(ctx, client, spaceID, processID, d.Timeout(schema.TimeoutCreate), func(steps []*deployments.DeploymentStep) ([]*deployments.DeploymentStep, error) {
+		return addProcessStep(steps, step)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	createdStep := findProcessStep(updatedProcess.getSteps(), "", step.Name)
+	if createdStep == nil {
+		return diag.Errorf("unable to find step '%s' in process (%s) after it was created", step.Name, processID)
+	}
+
+	if err := setProcessStep(ctx, d, processID, createdStep); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(processID + ":" + createdStep.ID)
+
+	log.Printf("[INFO] process step created (%s)", d.Id())
+	return nil
+}
+
+func resourceProcessStepDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] deleting process step (%s)", d.Id())
+
+	processID := d.Get("process_id").(string)
+	spaceID := d.Get("space_id").(string)
+	stepID := d.Get("step_id").(string)
+
+	client := m.(*client.Client)
+	_, err := updateProcessSteps(ctx, client, spaceID, processID, d.Timeout(schema.TimeoutDelete), func(steps []*deployments.DeploymentStep) ([]*deployments.DeploymentStep, error) {
+		return removeProcessStep(steps, stepID), nil
+	})
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "process step")
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] process step deleted")
+	return nil
+}
+
+func resourceProcessStepImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	log.Printf("[INFO] importing process step (%s)", d.Id())
+
+	importStrings := strings.SplitN(d.Id(), ":", 2)
+	if len(importStrings) != 2 {
+		return nil, fmt.Errorf("octopusdeploy_process_step import must be in the form of ProcessID:StepID or ProcessID:StepName (e.g. deploymentprocess-Projects-123:Hello world")
+	}
+
+	// the second part may be either the ID or the name of the step; the read resolves whichever matches
+	d.Set("process_id", importStrings[0])
+	d.Set("step_id", importStrings[1])
+	d.Set("name", importStrings[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceProcessStepRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading process step (%s)", d.Id())
+
+	processID := d.Get("process_id").(string)
+	spaceID := d.Get("space_id").(string)
+
+	client := m.(*client.Client)
+	process, err := getProcess(client, spaceID, processID)
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "process step")
+	}
+
+	step := findProcessStep(process.getSteps(), d.Get("step_id").(string), d.Get("name").(string))
+	if step == nil {
+		return errors.DeleteFromState(ctx, d, "process step")
+	}
+
+	if err := setProcessStep(ctx, d, processID, step); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(processID + ":" + step.ID)
+
+	log.Printf("[INFO] process step read (%s)", d.Id())
+	return nil
+}
+
+func resourceProcessStepUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] updating process step (%s)", d.Id())
+
+	processID := d.Get("process_id").(string)
+	spaceID := d.Get("space_id").(string)
+	step := expandProcessStep(ctx, d)
+
+	client := m.(*client.Client)
+	updatedProcess, err := updateProcessSteps(ctx, client, spaceID, processID, d.Timeout(schema.TimeoutUpdate), func(steps []*deployments.DeploymentStep) ([]*deployments.DeploymentStep, error) {
+		return replaceProcessStep(steps, step.ID, step)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	updatedStep := findProcessStep(updatedProcess.getSteps(), step.ID, "")
+	if updatedStep == nil {
+		return diag.Errorf("unable to find step (%s) in process (%s) after it was updated", step.ID, processID)
+	}
+
+	if err := setProcessStep(ctx, d, processID, updatedStep); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] process step updated (%s)", d.Id())
+	return nil
+}
@@ -0,0 +1,126 @@
+package octopusdeploy
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceProcessStepOrder() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceProcessStepOrderCreate,
+		DeleteContext: resourceProcessStepOrderDelete,
+		Description:   "This resource manages the order of steps within a deployment process or runbook process in Octopus Deploy. The listed steps are placed at the start of the process in the given order; any other steps follow them in their existing order.",
+		Importer:      getImporter(),
+		ReadContext:   resourceProcessStepOrderRead,
+		Schema: map[string]*schema.Schema{
+			"process_id": {
+				Description: "The ID of the deployment process or runbook process that contains the steps.",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"space_id": getSpaceIDSchema(),
+			"steps": {
+				Description: "The IDs of the steps in the order in which they should run.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				MinItems:    1,
+				Required:    true,
+				Type:        schema.TypeList,
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(5 * time.Minute),
+		},
+		UpdateContext: resourceProcessStepOrderUpdate,
+	}
+}
+
+func resourceProcessStepOrderCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	processID := d.Get("process_id").(string)
+
+	log.Printf("[INFO] creating process step order (%s)", processID)
+
+	if diags := applyProcessStepOrder(ctx, d, m, d.Timeout(schema.TimeoutCreate)); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(processID)
+
+	log.Printf("[INFO] process step order created (%s)", d.Id())
+	return resourceProcessStepOrderRead(ctx, d, m)
+}
+
+func resourceProcessStepOrderDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] deleting process step order (%s)", d.Id())
+
+	// the steps themselves are owned by other resources, so there is nothing to revert
+	d.SetId("")
+
+	log.Printf("[INFO] process step order deleted")
+	return nil
+}
+
+func resourceProcessStepOrderRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading process step order (%s)", d.Id())
+
+	spaceID := d.Get("space_id").(string)
+
+	client := m.(*client.Client)
+	process, err := getProcess(client, spaceID, d.Id())
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "process step order")
+	}
+
+	// only the steps this resource manages are reported, in the order in which they currently appear
+	managedStepIDs := map[string]bool{}
+	for _, stepID := range getSliceFromTerraformTypeList(d.Get("steps")) {
+		managedStepIDs[stepID] = true
+	}
+
+	steps := []string{}
+	for _, step := range process.getSteps() {
+		if len(managedStepIDs) == 0 || managedStepIDs[step.ID] {
+			steps = append(steps, step.ID)
+		}
+	}
+
+	d.Set("process_id", d.Id())
+	d.Set("steps", steps)
+
+	log.Printf("[INFO] process step order read (%s)", d.Id())
+	return nil
+}
+
+func resourceProcessStepOrderUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] updating process step order (%s)", d.Id())
+
+	if diags := applyProcessStepOrder(ctx, d, m, d.Timeout(schema.TimeoutUpdate)); diags.HasError() {
+		return diags
+	}
+
+	log.Printf("[INFO] process step order updated (%s)", d.Id())
+	return resourceProcessStepOrderRead(ctx, d, m)
+}
+
+func applyProcessStepOrder(ctx context.Context, d *schema.ResourceData, m interface{}, timeout time.Duration) diag.Diagnostics {
+	processID := d.Get("process_id").(string)
+	spaceID := d.Get("space_id").(string)
+	stepIDs := getSliceFromTerraformTypeList(d.Get("steps"))
+
+	client := m.(*client.Client)
+	_, err := updateProcessSteps(ctx, client, spaceID, processID, timeout, func(steps []*deployments.DeploymentStep) ([]*deployments.DeploymentStep, error) {
+		return reorderProcessSteps(steps, stepIDs)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
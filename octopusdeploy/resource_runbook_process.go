@@ -2,11 +2,14 @@ package octopusdeploy
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"net/url"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/runbookprocess"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/runbooks"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services/api"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,6 +18,7 @@ import (
 func resourceRunbookProcess() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceRunbookProcessCreate,
+		CustomizeDiff: validateNestedTenantTagsDiff("step"),
 		DeleteContext: resourceRunbookProcessDelete,
 		Description:   "This resource manages runbook processes in Octopus Deploy.",
 		Importer:      getImporter(),
@@ -25,7 +29,7 @@ func resourceRunbookProcess() *schema.Resource {
 }
 
 func getRunbookProcessSchema() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
+	runbookProcessSchema := map[string]*schema.Schema{
 		"id": getIDSchema(),
 		"last_snapshot_id": {
 			Description: "Read only value containing the last snapshot ID.",
@@ -52,11 +56,19 @@ func getRunbookProcessSchema() map[string]*schema.Schema {
 			Type:        schema.TypeInt,
 		},
 	}
+
+	addGitRefSchema(runbookProcessSchema, false)
+
+	return runbookProcessSchema
 }
 
 // resourceRunbookProcessCreate "creates" a new runbook deployment process. In reality every runbook has a deployment process
 // already, so this function retrieves the existing process and updates it.
 func resourceRunbookProcessCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateNestedResourceTenantTags(ctx, d, m, "step"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	client := m.(*client.Client)
 	runbookProcess := expandRunbookProcess(ctx, d, client)
 
@@ -68,13 +80,17 @@ func resourceRunbookProcessCreate(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	var current *runbookprocess.RunbookProcess
-	current, err = runbookprocess.GetByID(client, d.Get("space_id").(string), runbook.RunbookProcessID)
+	current, err = getRunbookProcess(d, client, d.Get("space_id").(string), runbook.ProjectID, runbook.RunbookProcessID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	runbookProcess.ID = current.ID
 	runbookProcess.Links = current.Links
+	runbookProcess.ProjectID = runbook.ProjectID
 	runbookProcess.Version = current.Version
 
-	createdRunbookProcess, err := runbookprocess.Update(client, runbookProcess)
+	createdRunbookProcess, err := updateRunbookProcess(d, client, runbookProcess)
 
 	if err != nil {
 		return diag.FromErr(err)
@@ -97,14 +113,15 @@ func resourceRunbookProcessDelete(ctx context.Context, d *schema.ResourceData, m
 
 	// "Deleting" a runbook process just means to clear it out
 	client := m.(*client.Client)
-	current, err := runbookprocess.GetByID(client, d.Get("space_id").(string), d.Id())
+	current, err := getRunbookProcess(d, client, d.Get("space_id").(string), d.Get("project_id").(string), d.Id())
 
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	runbookProcess := &runbookprocess.RunbookProcess{
-		Version: current.Version,
+		ProjectID: current.ProjectID,
+		Version:   current.Version,
 	}
 	runbookProcess.Links = current.Links
 	runbookProcess.ID = d.Id()
@@ -112,7 +129,7 @@ func resourceRunbookProcessDelete(ctx context.Context, d *schema.ResourceData, m
 		runbookProcess.SpaceID = v.(string)
 	}
 
-	_, err = runbookprocess.Update(client, runbookProcess)
+	_, err = updateRunbookProcess(d, client, runbookProcess)
 
 	if err != nil {
 		return diag.FromErr(err)
@@ -127,7 +144,7 @@ func resourceRunbookProcessRead(ctx context.Context, d *schema.ResourceData, m i
 	log.Printf("[INFO] reading runbook process (%s)", d.Id())
 
 	client := m.(*client.Client)
-	runbookProcess, err := runbookprocess.GetByID(client, d.Get("space_id").(string), d.Id())
+	runbookProcess, err := getRunbookProcess(d, client, d.Get("space_id").(string), d.Get("project_id").(string), d.Id())
 
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "runbook_process")
@@ -142,11 +159,15 @@ func resourceRunbookProcessRead(ctx context.Context, d *schema.ResourceData, m i
 }
 
 func resourceRunbookProcessUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateNestedResourceTenantTags(ctx, d, m, "step"); err != nil {
+		return diag.FromErr(err)
+	}
+
 	log.Printf("[INFO] updating runbook process (%s)", d.Id())
 
 	client := m.(*client.Client)
 	runbookProcess := expandRunbookProcess(ctx, d, client)
-	current, err := runbookprocess.GetByID(client, runbookProcess.SpaceID, d.Id())
+	current, err := getRunbookProcess(d, client, runbookProcess.SpaceID, runbookProcess.ProjectID, d.Id())
 
 	if err != nil {
 		return diag.FromErr(err)
@@ -155,7 +176,7 @@ func resourceRunbookProcessUpdate(ctx context.Context, d *schema.ResourceData, m
 	runbookProcess.Links = current.Links
 	runbookProcess.Version = current.Version
 
-	updatedRunbookProcess, err := runbookprocess.Update(client, runbookProcess)
+	updatedRunbookProcess, err := updateRunbookProcess(d, client, runbookProcess)
 
 	if err != nil {
 		return diag.FromErr(err)
@@ -168,3 +189,60 @@ func resourceRunbookProcessUpdate(ctx context.Context, d *schema.ResourceData, m
 	log.Printf("[INFO] deployment process updated (%s)", d.Id())
 	return nil
 }
+
+// getRunbookProcess reads a runbook process from the database or, when git_ref is set, from the branch of a
+// version-controlled project.
+func getRunbookProcess(d *schema.ResourceData, client *client.Client, spaceID string, projectID string, id string) (*runbookprocess.RunbookProcess, error) {
+	gitRef := d.Get("git_ref").(string)
+	if len(gitRef) == 0 {
+		return runbookprocess.GetByID(client, spaceID, id)
+	}
+
+	if len(spaceID) == 0 {
+		spaceID = client.GetSpaceID()
+	}
+
+	path := fmt.Sprintf("/api/%s/projects/%s/%s/runbookProcesses/%s", spaceID, projectID, url.PathEscape(gitRef), id)
+	resp, err := api.ApiGet(client.Sling(), new(runbookprocess.RunbookProcess), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*runbookprocess.RunbookProcess), nil
+}
+
+// updateRunbookProcess writes a runbook process. When git_ref is set, the change is committed to that branch of the
+// version-controlled project and the resulting commit is recorded.
+func updateRunbookProcess(d *schema.ResourceData, client *client.Client, runbookProcess *runbookprocess.RunbookProcess) (*runbookprocess.RunbookProcess, error) {
+	gitRef := d.Get("git_ref").(string)
+	if len(gitRef) == 0 {
+		return runbookprocess.Update(client, runbookProcess)
+	}
+
+	project, err := client.Projects.GetByID(runbookProcess.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	gitPersistenceSettings := getGitPersistenceSettings(project)
+	if gitPersistenceSettings == nil {
+		return nil, fmt.Errorf("git_ref can only be set for runbooks of projects that are stored in version control")
+	}
+
+	if err := validateGitRefCommit(d, gitPersistenceSettings, gitRef); err != nil {
+		return nil, err
+	}
+
+	updatedRunbookProcess := new(runbookprocess.RunbookProcess)
+	if err := commitVersionControlledResource(client, runbookProcess.Links["Self"], runbookProcess, getGitCommitMessage(d, "runbook process"), updatedRunbookProcess); err != nil {
+		return nil, err
+	}
+
+	gitCommit, err := getGitCommit(client, project.SpaceID, project.GetID(), gitRef)
+	if err != nil {
+		log.Printf("[WARN] unable to determine the commit for runbook process (%s): %s", runbookProcess.GetID(), err)
+	}
+	d.Set("git_commit", gitCommit)
+
+	return updatedRunbookProcess, nil
+}
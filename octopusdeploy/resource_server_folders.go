@@ -0,0 +1,95 @@
+package octopusdeploy
+
+import (
+	"context"
+	"log"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/serverconfiguration"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceServerFolders() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceServerFoldersUpdate,
+		DeleteContext: resourceServerFoldersDelete,
+		Description:   "This resource manages the folders that the Octopus Deploy server stores artifacts, task logs and event exports in. Folders that are not set keep their current location. Destroying this resource restores the default folders, which are located in the home directory of the server.",
+		Importer:      getImporter(),
+		ReadContext:   resourceServerFoldersRead,
+		Schema: map[string]*schema.Schema{
+			"artifacts_directory": {
+				Computed:    true,
+				Description: "The folder that artifacts collected during deployments are stored in.",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"event_exports_directory": {
+				Computed:    true,
+				Description: "The folder that archived audit events are stored in.",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"id": getIDSchema(),
+			"task_logs_directory": {
+				Computed:    true,
+				Description: "The folder that the logs of tasks are stored in.",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+		},
+		UpdateContext: resourceServerFoldersUpdate,
+	}
+}
+
+func resourceServerFoldersDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] restoring default server folders (%s)", d.Id())
+
+	client := m.(*client.Client)
+	if err := serverconfiguration.UpdateServerFoldersConfiguration(client, &serverconfiguration.ServerFoldersConfiguration{}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] default server folders restored")
+	return nil
+}
+
+func resourceServerFoldersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading server folders (%s)", d.Id())
+
+	client := m.(*client.Client)
+	configuration, err := serverconfiguration.GetServerFoldersConfiguration(client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("artifacts_directory", configuration.ArtifactsDirectory)
+	d.Set("event_exports_directory", configuration.EventExportsDirectory)
+	d.Set("task_logs_directory", configuration.TaskLogsDirectory)
+	d.SetId(serverconfiguration.ServerFoldersConfigurationID)
+
+	log.Printf("[INFO] server folders read (%s)", d.Id())
+	return nil
+}
+
+func resourceServerFoldersUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	configuration := &serverconfiguration.ServerFoldersConfiguration{
+		ArtifactsDirectory:    d.Get("artifacts_directory").(string),
+		EventExportsDirectory: d.Get("event_exports_directory").(string),
+		TaskLogsDirectory:     d.Get("task_logs_directory").(string),
+	}
+
+	log.Printf("[INFO] updating server folders (%s)", serverconfiguration.ServerFoldersConfigurationID)
+
+	client := m.(*client.Client)
+	if err := serverconfiguration.UpdateServerFoldersConfiguration(client, configuration); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(serverconfiguration.ServerFoldersConfigurationID)
+
+	log.Printf("[INFO] server folders updated (%s)", d.Id())
+	return resourceServerFoldersRead(ctx, d, m)
+}
@@ -0,0 +1,104 @@
+package octopusdeploy
+
+import (
+	"context"
+	"log"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/serverconfiguration"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceServerTaskCap() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceServerTaskCapUpdate,
+		DeleteContext: resourceServerTaskCapDelete,
+		Description:   "This resource manages the task cap of an Octopus Deploy server node, which is the number of tasks (i.e. deployments) that the node runs at the same time. Destroying this resource restores the default task cap.",
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				d.Set("node_id", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		ReadContext: resourceServerTaskCapRead,
+		Schema: map[string]*schema.Schema{
+			"id": getIDSchema(),
+			"max_concurrent_tasks": {
+				Description:      "The number of tasks that the node runs at the same time.",
+				Required:         true,
+				Type:             schema.TypeInt,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+			},
+			"name": {
+				Computed:    true,
+				Description: "The name of the node.",
+				Type:        schema.TypeString,
+			},
+			"node_id": {
+				Description: "The ID of the server node (i.e. `OctopusServerNodes-octopus-01`).",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+		},
+		UpdateContext: resourceServerTaskCapUpdate,
+	}
+}
+
+func resourceServerTaskCapDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] restoring default task cap of server node (%s)", d.Id())
+
+	taskCap := &serverconfiguration.ServerNodeTaskCap{
+		MaxConcurrentTasks: serverconfiguration.DefaultMaxConcurrentTasks,
+	}
+
+	client := m.(*client.Client)
+	if err := serverconfiguration.UpdateServerNodeTaskCap(client, d.Get("node_id").(string), taskCap); err != nil {
+		return errors.ProcessApiError(ctx, d, err, "server task cap")
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] default task cap of server node restored")
+	return nil
+}
+
+func resourceServerTaskCapRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading task cap of server node (%s)", d.Id())
+
+	client := m.(*client.Client)
+	node, err := serverconfiguration.GetServerNode(client, d.Get("node_id").(string))
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "server task cap")
+	}
+
+	d.Set("max_concurrent_tasks", node.MaxConcurrentTasks)
+	d.Set("name", node.Name)
+	d.Set("node_id", node.GetID())
+	d.SetId(node.GetID())
+
+	log.Printf("[INFO] task cap of server node read (%s)", d.Id())
+	return nil
+}
+
+func resourceServerTaskCapUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	nodeID := d.Get("node_id").(string)
+	taskCap := &serverconfiguration.ServerNodeTaskCap{
+		MaxConcurrentTasks: d.Get("max_concurrent_tasks").(int),
+	}
+
+	log.Printf("[INFO] updating task cap of server node (%s)", nodeID)
+
+	client := m.(*client.Client)
+	if err := serverconfiguration.UpdateServerNodeTaskCap(client, nodeID, taskCap); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(nodeID)
+
+	log.Printf("[INFO] task cap of server node updated (%s)", d.Id())
+	return resourceServerTaskCapRead(ctx, d, m)
+}
@@ -0,0 +1,123 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/users"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/serviceaccounts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceServiceAccountOIDCIdentity() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceServiceAccountOIDCIdentityCreate,
+		DeleteContext: resourceServiceAccountOIDCIdentityDelete,
+		Description:   "This resource manages OIDC identities of service accounts in Octopus Deploy. An OIDC identity allows tokens issued by a CI system (i.e. GitHub Actions) to be exchanged for access to Octopus Deploy as the service account, without storing an API key.",
+		Importer:      &schema.ResourceImporter{StateContext: resourceServiceAccountOIDCIdentityImporter},
+		ReadContext:   resourceServiceAccountOIDCIdentityRead,
+		Schema:        getServiceAccountOIDCIdentitySchema(),
+		UpdateContext: resourceServiceAccountOIDCIdentityUpdate,
+	}
+}
+
+func resourceServiceAccountOIDCIdentityCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	identity := expandServiceAccountOIDCIdentity(d)
+
+	log.Printf("[INFO] creating OIDC identity: %#v", identity)
+
+	client := m.(*client.Client)
+	serviceAccount, err := users.GetByID(client, identity.ServiceAccountID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !serviceAccount.IsService {
+		return diag.Errorf("OIDC identities can only be added to service accounts; user %s (%s) does not have is_service set", serviceAccount.Username, serviceAccount.GetID())
+	}
+
+	createdIdentity, err := serviceaccounts.Add(client, identity)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(createdIdentity.ID)
+
+	log.Printf("[INFO] OIDC identity created (%s)", d.Id())
+	return resourceServiceAccountOIDCIdentityRead(ctx, d, m)
+}
+
+func resourceServiceAccountOIDCIdentityDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] deleting OIDC identity (%s)", d.Id())
+
+	client := m.(*client.Client)
+	if err := serviceaccounts.DeleteByID(client, d.Get("service_account_id").(string), d.Id()); err != nil {
+		return errors.ProcessApiError(ctx, d, err, "OIDC identity")
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] OIDC identity deleted")
+	return nil
+}
+
+func resourceServiceAccountOIDCIdentityImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	log.Printf("[INFO] importing OIDC identity (%s)", d.Id())
+
+	importStrings := strings.Split(d.Id(), ":")
+	if len(importStrings) != 2 {
+		return nil, fmt.Errorf("octopusdeploy_service_account_oidc_identity import must be in the form of ServiceAccountID:OIDCIdentityID (e.g. Users-123:ServiceAccountOidcIdentities-123)")
+	}
+
+	d.Set("service_account_id", importStrings[0])
+	d.SetId(importStrings[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceServiceAccountOIDCIdentityRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading OIDC identity (%s)", d.Id())
+
+	serviceAccountID := d.Get("service_account_id").(string)
+
+	client := m.(*client.Client)
+	identities, err := serviceaccounts.GetAll(client, serviceAccountID)
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "OIDC identity")
+	}
+
+	for _, identity := range identities.OIDCIdentities {
+		if identity.ID != d.Id() {
+			continue
+		}
+
+		if len(identity.ServiceAccountID) == 0 {
+			identity.ServiceAccountID = serviceAccountID
+		}
+		setServiceAccountOIDCIdentity(ctx, d, identity, identities.ExternalID)
+
+		log.Printf("[INFO] OIDC identity read (%s)", d.Id())
+		return nil
+	}
+
+	return errors.DeleteFromState(ctx, d, "OIDC identity")
+}
+
+func resourceServiceAccountOIDCIdentityUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] updating OIDC identity (%s)", d.Id())
+
+	identity := expandServiceAccountOIDCIdentity(d)
+
+	client := m.(*client.Client)
+	if _, err := serviceaccounts.Update(client, identity); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] OIDC identity updated (%s)", d.Id())
+	return resourceServiceAccountOIDCIdentityRead(ctx, d, m)
+}
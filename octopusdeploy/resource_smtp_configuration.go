@@ -0,0 +1,68 @@
+package octopusdeploy
+
+import (
+	"context"
+	"log"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/serverconfiguration"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceSMTPConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSMTPConfigurationUpdate,
+		DeleteContext: resourceSMTPConfigurationDelete,
+		Description:   "This resource manages the SMTP configuration of the Octopus Deploy server, which is used to send emails. The SMTP configuration always exists, so destroying this resource restores the default configuration, which does not send emails.",
+		Importer:      getImporter(),
+		ReadContext:   resourceSMTPConfigurationRead,
+		Schema:        getSMTPConfigurationSchema(),
+		UpdateContext: resourceSMTPConfigurationUpdate,
+	}
+}
+
+func resourceSMTPConfigurationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] restoring default SMTP configuration (%s)", d.Id())
+
+	client := m.(*client.Client)
+	if err := serverconfiguration.UpdateSMTPConfiguration(client, serverconfiguration.NewDefaultSMTPConfiguration()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] default SMTP configuration restored")
+	return nil
+}
+
+func resourceSMTPConfigurationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading SMTP configuration (%s)", d.Id())
+
+	client := m.(*client.Client)
+	configuration, err := serverconfiguration.GetSMTPConfiguration(client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	setSMTPConfiguration(ctx, d, configuration)
+
+	log.Printf("[INFO] SMTP configuration read (%s)", d.Id())
+	return nil
+}
+
+func resourceSMTPConfigurationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	configuration := expandSMTPConfiguration(d)
+
+	log.Printf("[INFO] updating SMTP configuration (%s)", serverconfiguration.SMTPConfigurationID)
+
+	client := m.(*client.Client)
+	if err := serverconfiguration.UpdateSMTPConfiguration(client, configuration); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(serverconfiguration.SMTPConfigurationID)
+
+	log.Printf("[INFO] SMTP configuration updated (%s)", d.Id())
+	return resourceSMTPConfigurationRead(ctx, d, m)
+}
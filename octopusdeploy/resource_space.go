@@ -3,6 +3,7 @@ package octopusdeploy
 import (
 	"context"
 	"log"
+	"time"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/spaces"
@@ -19,6 +20,9 @@ func resourceSpace() *schema.Resource {
 		Importer:      getImporter(),
 		ReadContext:   resourceSpaceRead,
 		Schema:        getSpaceSchema(),
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
 		UpdateContext: resourceSpaceUpdate,
 	}
 }
@@ -47,15 +51,33 @@ func resourceSpaceCreate(ctx context.Context, d *schema.ResourceData, m interfac
 func resourceSpaceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] deleting space (%s)", d.Id())
 
+	client := m.(*client.Client)
+
+	if d.Get("prevent_destroy_if_not_empty").(bool) {
+		contents, err := getSpaceContents(client, d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if !contents.isEmpty() {
+			return diag.Diagnostics{getSpaceNotEmptyDiagnostic(d.Get("name").(string), contents)}
+		}
+	}
+
 	space := expandSpace(d)
 	space.TaskQueueStopped = true
 
-	client := m.(*client.Client)
 	updatedSpace, err := spaces.Update(client, space)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	if d.Get("drain_before_destroy").(bool) {
+		if err := drainSpaceTaskQueue(ctx, client, updatedSpace.GetID(), d.Timeout(schema.TimeoutDelete)); err != nil {
+			return diag.Errorf("error waiting for the task queue of space %s to drain; the task queue remains stopped: %s", updatedSpace.GetID(), err)
+		}
+	}
+
 	if err := client.Spaces.DeleteByID(updatedSpace.GetID()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -27,9 +27,10 @@ func TestAccSpaceImportBasic(t *testing.T) {
 				Config: testSpaceBasic(localName, name, slug),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"drain_before_destroy", "prevent_destroy_if_not_empty"},
 			},
 		},
 	})
@@ -72,6 +73,47 @@ func TestAccSpaceBasic(t *testing.T) {
 	})
 }
 
+func TestAccSpaceGuardedDestroy(t *testing.T) {
+	localName := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	name := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	slug := acctest.RandStringFromCharSet(20, acctest.CharSetAlpha)
+	prefix := "octopusdeploy_space." + localName
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccSpaceCheckDestroy,
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Check: resource.ComposeTestCheckFunc(
+					testSpaceExists(prefix),
+					resource.TestCheckResourceAttr(prefix, "drain_before_destroy", "true"),
+					resource.TestCheckResourceAttr(prefix, "prevent_destroy_if_not_empty", "true"),
+				),
+				Config: testSpaceGuardedDestroy(localName, name, slug),
+			},
+		},
+	})
+}
+
+func testSpaceGuardedDestroy(localName string, name string, slug string) string {
+	return fmt.Sprintf(`resource "octopusdeploy_space" "%s" {
+		drain_before_destroy         = true
+		name                         = "%s"
+		prevent_destroy_if_not_empty = true
+		slug                         = "%s"
+		space_managers_teams         = ["teams-managers"]
+
+		lifecycle {
+		  ignore_changes = [space_managers_teams]
+		}
+
+		timeouts {
+		  delete = "5m"
+		}
+	}`, localName, name, slug)
+}
+
 func testSpaceDataSource(localName string, name string, slug string) string {
 	return fmt.Sprintf(testSpaceBasic(localName, name, slug)+"\n"+
 		`data "octopusdeploy_spaces" "%s" {
@@ -8,22 +8,29 @@ import (
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/machines"
 	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceSSHConnectionDeploymentTarget() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceSSHConnectionDeploymentTargetCreate,
+		CustomizeDiff: customdiff.All(validateProxyIDDiff, customizeTenantTagsDiff, validateWaitForHealthDiff),
 		DeleteContext: resourceSSHConnectionDeploymentTargetDelete,
 		Description:   "This resource manages SSH connection deployment targets in Octopus Deploy.",
 		Importer:      getImporter(),
 		ReadContext:   resourceSSHConnectionDeploymentTargetRead,
-		Schema:        getSSHConnectionDeploymentTargetSchema(),
+		Schema:        addTenantTagSchema(addWaitForHealthSchema(getSSHConnectionDeploymentTargetSchema())),
+		Timeouts:      getWaitForHealthTimeouts(),
 		UpdateContext: resourceSSHConnectionDeploymentTargetUpdate,
 	}
 }
 
 func resourceSSHConnectionDeploymentTargetCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateResourceTenantTags(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
 	deploymentTarget := expandSSHConnectionDeploymentTarget(d)
 
 	log.Printf("[INFO] creating SSH connection deployment target: %#v", deploymentTarget)
@@ -41,7 +48,7 @@ func resourceSSHConnectionDeploymentTargetCreate(ctx context.Context, d *schema.
 	d.SetId(createdDeploymentTarget.GetID())
 
 	log.Printf("[INFO] SSH connection deployment target created (%s)", d.Id())
-	return nil
+	return waitForDeploymentTargetHealth(ctx, d, client)
 }
 
 func resourceSSHConnectionDeploymentTargetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -76,6 +83,10 @@ func resourceSSHConnectionDeploymentTargetRead(ctx context.Context, d *schema.Re
 }
 
 func resourceSSHConnectionDeploymentTargetUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateResourceTenantTags(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
 	log.Printf("[INFO] updating SSH connection deployment target (%s)", d.Id())
 
 	deploymentTarget := expandSSHConnectionDeploymentTarget(d)
@@ -90,5 +101,5 @@ func resourceSSHConnectionDeploymentTargetUpdate(ctx context.Context, d *schema.
 	}
 
 	log.Printf("[INFO] SSH connection deployment target updated (%s)", d.Id())
-	return nil
+	return waitForDeploymentTargetHealth(ctx, d, client)
 }
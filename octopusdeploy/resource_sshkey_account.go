@@ -14,16 +14,21 @@ import (
 func resourceSSHKeyAccount() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceSSHKeyAccountCreate,
+		CustomizeDiff: customizeTenantTagsDiff,
 		DeleteContext: resourceSSHKeyAccountDelete,
 		Description:   "This resource manages SSH key accounts in Octopus Deploy.",
 		Importer:      getImporter(),
 		ReadContext:   resourceSSHKeyAccountRead,
-		Schema:        getSSHKeyAccountSchema(),
+		Schema:        addTenantTagSchema(getSSHKeyAccountSchema()),
 		UpdateContext: resourceSSHKeyAccountUpdate,
 	}
 }
 
 func resourceSSHKeyAccountCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateResourceTenantTags(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
 	account := expandSSHKeyAccount(d)
 
 	log.Printf("[INFO] creating SSH key account: %#v", account)
@@ -77,6 +82,10 @@ func resourceSSHKeyAccountRead(ctx context.Context, d *schema.ResourceData, m in
 }
 
 func resourceSSHKeyAccountUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateResourceTenantTags(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
 	log.Printf("[INFO] updating SSH key account (%s)", d.Id())
 
 	account := expandSSHKeyAccount(d)
@@ -29,7 +29,7 @@ func TestSSHKeyBasic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccountExists(prefix),
 					resource.TestCheckResourceAttr(prefix, "name", name),
-					resource.TestCheckResourceAttr(prefix, "private_key_passphrase", passphrase),
+					resource.TestCheckResourceAttr(prefix, "private_key_passphrase", hashAccountSecret(passphrase)),
 					resource.TestCheckResourceAttr(prefix, "tenanted_deployment_participation", string(tenantedDeploymentParticipation)),
 					resource.TestCheckResourceAttr(prefix, "username", username),
 				),
@@ -0,0 +1,104 @@
+package octopusdeploy
+
+import (
+	"context"
+	"log"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/subscriptions"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceSubscription() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSubscriptionCreate,
+		DeleteContext: resourceSubscriptionDelete,
+		Description:   "This resource manages subscriptions in Octopus Deploy. A subscription sends email digests or webhooks to teams when events that match its filters occur, such as deployments failing or audit events.",
+		Importer:      getImporter(),
+		ReadContext:   resourceSubscriptionRead,
+		Schema:        getSubscriptionSchema(),
+		UpdateContext: resourceSubscriptionUpdate,
+	}
+}
+
+func resourceSubscriptionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	subscription := expandSubscription(d)
+
+	log.Printf("[INFO] creating subscription: %#v", subscription)
+
+	client := m.(*client.Client)
+	createdSubscription, err := subscriptions.Add(client, subscription)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setSubscription(ctx, d, createdSubscription); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(createdSubscription.GetID())
+
+	log.Printf("[INFO] subscription created (%s)", d.Id())
+	return nil
+}
+
+func resourceSubscriptionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] deleting subscription (%s)", d.Id())
+
+	var spaceID string
+	if v, ok := d.GetOk("space_id"); ok {
+		spaceID = v.(string)
+	}
+
+	client := m.(*client.Client)
+	if err := subscriptions.DeleteByID(client, spaceID, d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] subscription deleted (%s)", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func resourceSubscriptionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading subscription (%s)", d.Id())
+
+	var spaceID string
+	if v, ok := d.GetOk("space_id"); ok {
+		spaceID = v.(string)
+	}
+
+	client := m.(*client.Client)
+	subscription, err := subscriptions.GetByID(client, spaceID, d.Id())
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "subscription")
+	}
+
+	if err := setSubscription(ctx, d, subscription); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] subscription read (%s)", d.Id())
+	return nil
+}
+
+func resourceSubscriptionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] updating subscription (%s)", d.Id())
+
+	subscription := expandSubscription(d)
+
+	client := m.(*client.Client)
+	updatedSubscription, err := subscriptions.Update(client, subscription)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setSubscription(ctx, d, updatedSubscription); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] subscription updated (%s)", d.Id())
+	return nil
+}
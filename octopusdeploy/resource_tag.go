@@ -18,6 +18,7 @@ import (
 func resourceTag() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceTagCreate,
+		CustomizeDiff: recordPlannedTagDiff,
 		DeleteContext: resourceTagDelete,
 		Description:   "This resource manages tags in Octopus Deploy.",
 		Importer:      getImporter(),
@@ -16,6 +16,7 @@ import (
 func resourceTagSet() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceTagSetCreate,
+		CustomizeDiff: recordPlannedTagSetDiff,
 		DeleteContext: resourceTagSetDelete,
 		Description:   "This resource manages tag sets in Octopus Deploy.",
 		Importer:      getImporter(),
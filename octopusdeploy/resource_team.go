@@ -96,6 +96,23 @@ func resourceTeamUpdate(ctx context.Context, d *schema.ResourceData, m interface
 
 	team := expandTeam(d)
 	client := m.(*client.Client)
+
+	unlock := lockTeam(d.Id())
+	defer unlock()
+
+	if d.Get("ignore_unmanaged_membership").(bool) {
+		existingTeam, err := client.Teams.GetByID(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		oldUsers, newUsers := d.GetChange("users")
+		team.MemberUserIDs = mergeManagedTeamMembers(existingTeam.MemberUserIDs, getSliceFromTerraformTypeList(oldUsers), getSliceFromTerraformTypeList(newUsers))
+
+		oldExternalSecurityGroups, newExternalSecurityGroups := d.GetChange("external_security_group")
+		team.ExternalSecurityGroups = mergeManagedTeamExternalSecurityGroups(existingTeam.ExternalSecurityGroups, expandExternalSecurityGroups(oldExternalSecurityGroups.([]interface{})), expandExternalSecurityGroups(newExternalSecurityGroups.([]interface{})))
+	}
+
 	updatedTeam, err := client.Teams.Update(team)
 	if err != nil {
 		return diag.FromErr(err)
@@ -0,0 +1,158 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/teams"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceTeamExternalGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTeamExternalGroupCreate,
+		DeleteContext: resourceTeamExternalGroupDelete,
+		Description:   "This resource manages a single external security group (i.e. an Active Directory, LDAP or identity provider group) of a team in Octopus Deploy. Other external security groups of the team are left untouched. When the team is also managed by `octopusdeploy_team`, set `ignore_unmanaged_membership` on it.",
+		Importer:      &schema.ResourceImporter{StateContext: resourceTeamExternalGroupImporter},
+		ReadContext:   resourceTeamExternalGroupRead,
+		Schema: map[string]*schema.Schema{
+			"display_id_and_name": {
+				Default:     false,
+				Description: "Indicates whether both the ID and the display name of the group are shown.",
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
+			"display_name": {
+				Computed:    true,
+				Description: "The display name of the group.",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"group_id": {
+				Description: "The ID of the group in the authentication provider.",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"team_id": {
+				Description: "The ID of the team.",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+		},
+		UpdateContext: resourceTeamExternalGroupUpdate,
+	}
+}
+
+func expandTeamExternalGroup(d *schema.ResourceData) core.NamedReferenceItem {
+	return core.NamedReferenceItem{
+		DisplayIDAndName: d.Get("display_id_and_name").(bool),
+		DisplayName:      d.Get("display_name").(string),
+		ID:               d.Get("group_id").(string),
+	}
+}
+
+func resourceTeamExternalGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	teamID := d.Get("team_id").(string)
+	externalSecurityGroup := expandTeamExternalGroup(d)
+
+	log.Printf("[INFO] adding external security group (%s) to team (%s)", externalSecurityGroup.ID, teamID)
+
+	client := m.(*client.Client)
+	if _, err := updateTeamMembership(client, teamID, func(team *teams.Team) error {
+		if findTeamExternalSecurityGroup(team.ExternalSecurityGroups, externalSecurityGroup.ID) != nil {
+			return fmt.Errorf("team %s already has the external security group %s; import it instead", teamID, externalSecurityGroup.ID)
+		}
+		team.ExternalSecurityGroups = setTeamExternalSecurityGroup(team.ExternalSecurityGroups, externalSecurityGroup)
+		return nil
+	}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(teamID + ":" + externalSecurityGroup.ID)
+
+	log.Printf("[INFO] team external group created (%s)", d.Id())
+	return resourceTeamExternalGroupRead(ctx, d, m)
+}
+
+func resourceTeamExternalGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] deleting team external group (%s)", d.Id())
+
+	groupID := d.Get("group_id").(string)
+
+	client := m.(*client.Client)
+	if _, err := updateTeamMembership(client, d.Get("team_id").(string), func(team *teams.Team) error {
+		team.ExternalSecurityGroups = removeTeamExternalSecurityGroup(team.ExternalSecurityGroups, groupID)
+		return nil
+	}); err != nil {
+		return errors.ProcessApiError(ctx, d, err, "team external group")
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] team external group deleted")
+	return nil
+}
+
+func resourceTeamExternalGroupImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	log.Printf("[INFO] importing team external group (%s)", d.Id())
+
+	importStrings := strings.SplitN(d.Id(), ":", 2)
+	if len(importStrings) != 2 {
+		return nil, fmt.Errorf("octopusdeploy_team_external_group import must be in the form of TeamID:GroupID (e.g. Teams-123:S-1-5-21-123)")
+	}
+
+	d.Set("team_id", importStrings[0])
+	d.Set("group_id", importStrings[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceTeamExternalGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading team external group (%s)", d.Id())
+
+	client := m.(*client.Client)
+	team, err := client.Teams.GetByID(d.Get("team_id").(string))
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "team external group")
+	}
+
+	externalSecurityGroup := findTeamExternalSecurityGroup(team.ExternalSecurityGroups, d.Get("group_id").(string))
+	if externalSecurityGroup == nil {
+		return errors.DeleteFromState(ctx, d, "team external group")
+	}
+
+	d.Set("display_id_and_name", externalSecurityGroup.DisplayIDAndName)
+	d.Set("display_name", externalSecurityGroup.DisplayName)
+
+	log.Printf("[INFO] team external group read (%s)", d.Id())
+	return nil
+}
+
+func resourceTeamExternalGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] updating team external group (%s)", d.Id())
+
+	teamID := d.Get("team_id").(string)
+	externalSecurityGroup := expandTeamExternalGroup(d)
+
+	client := m.(*client.Client)
+	if _, err := updateTeamMembership(client, teamID, func(team *teams.Team) error {
+		if findTeamExternalSecurityGroup(team.ExternalSecurityGroups, externalSecurityGroup.ID) == nil {
+			return fmt.Errorf("team %s no longer has the external security group %s", teamID, externalSecurityGroup.ID)
+		}
+		team.ExternalSecurityGroups = setTeamExternalSecurityGroup(team.ExternalSecurityGroups, externalSecurityGroup)
+		return nil
+	}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] team external group updated (%s)", d.Id())
+	return resourceTeamExternalGroupRead(ctx, d, m)
+}
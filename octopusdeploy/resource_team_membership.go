@@ -0,0 +1,115 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/teams"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceTeamMembership() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTeamMembershipCreate,
+		DeleteContext: resourceTeamMembershipDelete,
+		Description:   "This resource manages the membership of a single user in a team in Octopus Deploy. Other members of the team are left untouched. When the team is also managed by `octopusdeploy_team`, set `ignore_unmanaged_membership` on it.",
+		Importer:      &schema.ResourceImporter{StateContext: resourceTeamMembershipImporter},
+		ReadContext:   resourceTeamMembershipRead,
+		Schema: map[string]*schema.Schema{
+			"team_id": {
+				Description: "The ID of the team.",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"user_id": {
+				Description: "The ID of the user who is a member of the team.",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func resourceTeamMembershipCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	teamID := d.Get("team_id").(string)
+	userID := d.Get("user_id").(string)
+
+	log.Printf("[INFO] adding user (%s) to team (%s)", userID, teamID)
+
+	client := m.(*client.Client)
+	if _, err := updateTeamMembership(client, teamID, func(team *teams.Team) error {
+		for _, member := range team.MemberUserIDs {
+			if member == userID {
+				return fmt.Errorf("user %s is already a member of team %s; import it instead", userID, teamID)
+			}
+		}
+		team.MemberUserIDs = addTeamMember(team.MemberUserIDs, userID)
+		return nil
+	}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(teamID + ":" + userID)
+
+	log.Printf("[INFO] team membership created (%s)", d.Id())
+	return resourceTeamMembershipRead(ctx, d, m)
+}
+
+func resourceTeamMembershipDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] deleting team membership (%s)", d.Id())
+
+	userID := d.Get("user_id").(string)
+
+	client := m.(*client.Client)
+	if _, err := updateTeamMembership(client, d.Get("team_id").(string), func(team *teams.Team) error {
+		team.MemberUserIDs = removeTeamMember(team.MemberUserIDs, userID)
+		return nil
+	}); err != nil {
+		return errors.ProcessApiError(ctx, d, err, "team membership")
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] team membership deleted")
+	return nil
+}
+
+func resourceTeamMembershipImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	log.Printf("[INFO] importing team membership (%s)", d.Id())
+
+	importStrings := strings.Split(d.Id(), ":")
+	if len(importStrings) != 2 {
+		return nil, fmt.Errorf("octopusdeploy_team_membership import must be in the form of TeamID:UserID (e.g. Teams-123:Users-123)")
+	}
+
+	d.Set("team_id", importStrings[0])
+	d.Set("user_id", importStrings[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceTeamMembershipRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading team membership (%s)", d.Id())
+
+	userID := d.Get("user_id").(string)
+
+	client := m.(*client.Client)
+	team, err := client.Teams.GetByID(d.Get("team_id").(string))
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "team membership")
+	}
+
+	if len(getManagedTeamMembers(team.MemberUserIDs, []string{userID})) == 0 {
+		return errors.DeleteFromState(ctx, d, "team membership")
+	}
+
+	log.Printf("[INFO] team membership read (%s)", d.Id())
+	return nil
+}
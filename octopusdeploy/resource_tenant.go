@@ -14,16 +14,21 @@ import (
 func resourceTenant() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceTenantCreate,
+		CustomizeDiff: customizeTenantTagsDiff,
 		DeleteContext: resourceTenantDelete,
 		Description:   "This resource manages tenants in Octopus Deploy.",
 		Importer:      getImporter(),
 		ReadContext:   resourceTenantRead,
-		Schema:        getTenantSchema(),
+		Schema:        getTenantResourceSchema(),
 		UpdateContext: resourceTenantUpdate,
 	}
 }
 
 func resourceTenantCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateResourceTenantTags(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
 	tenant := expandTenant(d)
 
 	log.Printf("[INFO] creating tenant: %#v", tenant)
@@ -75,10 +80,28 @@ func resourceTenantRead(ctx context.Context, d *schema.ResourceData, m interface
 }
 
 func resourceTenantUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateResourceTenantTags(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
 	log.Printf("[INFO] updating tenant (%s)", d.Id())
 
 	tenant := expandTenant(d)
 	client := m.(*client.Client)
+
+	if d.Get("ignore_unmanaged_project_environments").(bool) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		existingTenant, err := tenants.GetByID(client, d.Get("space_id").(string), d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		previousProjectEnvironments, _ := d.GetChange("project_environment")
+		tenant.ProjectEnvironments = mergeProjectEnvironments(existingTenant.ProjectEnvironments, tenant.ProjectEnvironments, getProjectEnvironmentProjectIDs(previousProjectEnvironments))
+	}
+
 	updatedTenant, err := tenants.Update(client, tenant)
 	if err != nil {
 		return diag.FromErr(err)
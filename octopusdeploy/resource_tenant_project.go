@@ -0,0 +1,184 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tenants"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceTenantProject() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTenantProjectCreate,
+		DeleteContext: resourceTenantProjectDelete,
+		Description:   "This resource manages the connection of a tenant to a project in Octopus Deploy. It fails to create a connection that already exists, which can be imported instead, and leaves the other connections of the tenant as they are; set `ignore_unmanaged_project_environments` on an `octopusdeploy_tenant` that is managed by Terraform as well.",
+		Importer:      &schema.ResourceImporter{StateContext: resourceTenantProjectImporter},
+		ReadContext:   resourceTenantProjectRead,
+		Schema: map[string]*schema.Schema{
+			"environment_ids": {
+				Description: "A list of environment IDs of the project that the tenant is connected to.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Type:        schema.TypeSet,
+			},
+			"project_id": {
+				Description:      "The ID of the project that the tenant is connected to.",
+				ForceNew:         true,
+				Required:         true,
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+			},
+			"space_id": {
+				Computed:    true,
+				Description: "The space ID associated with this resource.",
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"tenant_id": {
+				Description:      "The ID of the tenant.",
+				ForceNew:         true,
+				Required:         true,
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+			},
+		},
+		UpdateContext: resourceTenantProjectUpdate,
+	}
+}
+
+func resourceTenantProjectCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	projectID := d.Get("project_id").(string)
+	tenantID := d.Get("tenant_id").(string)
+
+	id := tenantID + ":" + projectID
+
+	log.Printf("[INFO] creating tenant project (%s)", id)
+
+	client := m.(*client.Client)
+	tenant, err := tenants.GetByID(client, d.Get("space_id").(string), tenantID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, ok := tenant.ProjectEnvironments[projectID]; ok {
+		return diag.Errorf("tenant (%s) is already connected to project (%s); import it instead of creating it", tenantID, projectID)
+	}
+
+	if err := updateTenantProjectEnvironments(client, tenant, projectID, getSliceFromTerraformTypeList(d.Get("environment_ids"))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("space_id", tenant.SpaceID)
+	d.SetId(id)
+
+	log.Printf("[INFO] tenant project created (%s)", d.Id())
+	return nil
+}
+
+func resourceTenantProjectDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	log.Printf("[INFO] deleting tenant project (%s)", d.Id())
+
+	client := m.(*client.Client)
+	tenant, err := tenants.GetByID(client, d.Get("space_id").(string), d.Get("tenant_id").(string))
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "tenant project")
+	}
+
+	projectID := d.Get("project_id").(string)
+	if _, ok := tenant.ProjectEnvironments[projectID]; ok {
+		delete(tenant.ProjectEnvironments, projectID)
+		if _, err := tenants.Update(client, tenant); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	log.Printf("[INFO] tenant project deleted (%s)", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func resourceTenantProjectImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	log.Printf("[INFO] importing tenant project (%s)", d.Id())
+
+	importStrings := strings.Split(d.Id(), ":")
+	if len(importStrings) != 2 {
+		return nil, fmt.Errorf("octopusdeploy_tenant_project import must be in the form of TenantID:ProjectID (e.g. Tenants-123:Projects-456)")
+	}
+
+	d.Set("tenant_id", importStrings[0])
+	d.Set("project_id", importStrings[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceTenantProjectRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading tenant project (%s)", d.Id())
+
+	client := m.(*client.Client)
+	tenant, err := tenants.GetByID(client, d.Get("space_id").(string), d.Get("tenant_id").(string))
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "tenant project")
+	}
+
+	environmentIDs, ok := tenant.ProjectEnvironments[d.Get("project_id").(string)]
+	if !ok {
+		return errors.DeleteFromState(ctx, d, "tenant project")
+	}
+
+	d.Set("environment_ids", environmentIDs)
+	d.Set("space_id", tenant.SpaceID)
+
+	log.Printf("[INFO] tenant project read (%s)", d.Id())
+	return nil
+}
+
+func resourceTenantProjectUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	log.Printf("[INFO] updating tenant project (%s)", d.Id())
+
+	client := m.(*client.Client)
+	tenant, err := tenants.GetByID(client, d.Get("space_id").(string), d.Get("tenant_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := updateTenantProjectEnvironments(client, tenant, d.Get("project_id").(string), getSliceFromTerraformTypeList(d.Get("environment_ids"))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] tenant project updated (%s)", d.Id())
+	return nil
+}
+
+// updateTenantProjectEnvironments connects a tenant to a project and its environments without changing the other
+// connections of the tenant.
+func updateTenantProjectEnvironments(client *client.Client, tenant *tenants.Tenant, projectID string, environmentIDs []string) error {
+	if tenant.ProjectEnvironments == nil {
+		tenant.ProjectEnvironments = map[string][]string{}
+	}
+
+	if environmentIDs == nil {
+		environmentIDs = []string{}
+	}
+
+	tenant.ProjectEnvironments[projectID] = environmentIDs
+
+	_, err := tenants.Update(client, tenant)
+	return err
+}
@@ -0,0 +1,245 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/machines"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tasks"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/servertasks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// tentacleUpgradeBatchSize is the number of deployment targets that are read by ID in a single request.
+const tentacleUpgradeBatchSize = 50
+
+func resourceTentacleUpgrade() *schema.Resource {
+	return &schema.Resource{
+		// the wait for the upgrade is limited by the timeout attribute rather than the default create timeout
+		CreateWithoutTimeout: resourceTentacleUpgradeCreate,
+		CustomizeDiff:        resourceTentacleUpgradeCustomizeDiff,
+		DeleteContext:        resourceTentacleUpgradeDelete,
+		Description:          "This resource upgrades the tentacles of a set of deployment targets to the version that is bundled with Octopus Deploy and waits for the upgrade to complete. Destroying this resource does not downgrade the tentacles.",
+		ReadContext:          resourceTentacleUpgradeRead,
+		Schema:               getTentacleUpgradeSchema(),
+		UpdateContext:        resourceTentacleUpgradeUpdate,
+	}
+}
+
+func resourceTentacleUpgradeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*client.Client)
+	spaceID := d.Get("space_id").(string)
+	timeout, _ := time.ParseDuration(d.Get("timeout").(string))
+
+	deploymentTargets, err := getTentacleUpgradeDeploymentTargets(ctx, client, spaceID, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(deploymentTargets) == 0 {
+		return diag.Errorf("no deployment targets match the machine IDs, roles and environments of this tentacle upgrade")
+	}
+
+	machineIDs := []string{}
+	for _, deploymentTarget := range deploymentTargets {
+		machineIDs = append(machineIDs, deploymentTarget.GetID())
+	}
+
+	log.Printf("[INFO] upgrading the tentacles of %d deployment target(s)", len(machineIDs))
+
+	description := fmt.Sprintf("Upgrade the tentacles of %d deployment target(s)", len(machineIDs))
+	upgradeTask, diags := runTentacleUpgradeTask(ctx, client, spaceID, servertasks.NewTentacleUpgradeTask(spaceID, description, machineIDs), timeout)
+	if diags.HasError() {
+		return diags
+	}
+
+	d.SetId(upgradeTask.GetID())
+	d.Set("task_id", upgradeTask.GetID())
+	d.Set("task_state", upgradeTask.State)
+
+	if d.Get("update_calamari").(bool) {
+		log.Printf("[INFO] updating Calamari on %d deployment target(s)", len(machineIDs))
+
+		description := fmt.Sprintf("Update Calamari on %d deployment target(s)", len(machineIDs))
+		calamariTask, diags := runTentacleUpgradeTask(ctx, client, spaceID, servertasks.NewCalamariUpdateTask(spaceID, description, machineIDs), timeout)
+		if diags.HasError() {
+			d.SetId("")
+			return diags
+		}
+
+		d.Set("calamari_task_id", calamariTask.GetID())
+	}
+
+	deploymentTargets, err = getDeploymentTargetsByID(ctx, client, spaceID, machineIDs)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setTentacleUpgradeMachines(d, deploymentTargets); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] tentacle upgrade completed (%s)", d.Id())
+	return nil
+}
+
+// resourceTentacleUpgradeCustomizeDiff plans the upgrade again once the server bundles a newer version of the tentacle
+// than the one that is installed on one of the deployment targets.
+func resourceTentacleUpgradeCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if len(d.Id()) == 0 {
+		return nil
+	}
+
+	flattenedMachines, _ := d.GetChange("machines")
+	if !isTentacleUpgradePending(flattenedMachines.([]interface{})) {
+		return nil
+	}
+
+	log.Printf("[INFO] tentacle upgrade (%s) has deployment targets with an outdated tentacle", d.Id())
+
+	if err := d.SetNewComputed("machines"); err != nil {
+		return err
+	}
+	return d.ForceNew("machines")
+}
+
+func resourceTentacleUpgradeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] removing tentacle upgrade (%s) from the state; the tentacles are not downgraded", d.Id())
+
+	d.SetId("")
+	return nil
+}
+
+func resourceTentacleUpgradeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading tentacle upgrade (%s)", d.Id())
+
+	machineIDs := []string{}
+	for _, v := range d.Get("machines").([]interface{}) {
+		if flattenedMachine, ok := v.(map[string]interface{}); ok {
+			machineIDs = append(machineIDs, flattenedMachine["id"].(string))
+		}
+	}
+
+	client := m.(*client.Client)
+	deploymentTargets, err := getDeploymentTargetsByID(ctx, client, d.Get("space_id").(string), machineIDs)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setTentacleUpgradeMachines(d, deploymentTargets); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] tentacle upgrade read (%s)", d.Id())
+	return nil
+}
+
+func resourceTentacleUpgradeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// only timeout can change without upgrading the tentacles again, and it is not stored on the server
+	return resourceTentacleUpgradeRead(ctx, d, m)
+}
+
+// runTentacleUpgradeTask queues a task and waits for it to complete successfully.
+func runTentacleUpgradeTask(ctx context.Context, client *client.Client, spaceID string, task *tasks.Task, timeout time.Duration) (*tasks.Task, diag.Diagnostics) {
+	queuedTask, err := servertasks.Add(client, spaceID, task)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] queued task (%s): %s", queuedTask.GetID(), task.Description)
+
+	completedTask, err := waitForServerTask(ctx, client, spaceID, queuedTask.GetID(), timeout)
+	if err == nil && completedTask.State == servertasks.TaskStateSuccess {
+		return completedTask, nil
+	}
+
+	var detail string
+	if err != nil {
+		detail = err.Error()
+	} else {
+		detail = fmt.Sprintf("The task completed in the %s state. %s", completedTask.State, completedTask.ErrorMessage)
+	}
+
+	if taskLog := getServerTaskLog(client, spaceID, queuedTask.GetID()); len(taskLog) > 0 {
+		detail += fmt.Sprintf("\n\nLog of task %s:\n\n%s", queuedTask.GetID(), taskLog)
+	}
+
+	return nil, diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("task %s (%s) did not complete successfully", queuedTask.GetID(), task.Description),
+		Detail:   detail,
+	}}
+}
+
+// getTentacleUpgradeDeploymentTargets returns the deployment targets that are selected by the machine IDs, roles and
+// environments of a tentacle upgrade, without duplicates.
+func getTentacleUpgradeDeploymentTargets(ctx context.Context, client *client.Client, spaceID string, d *schema.ResourceData) ([]*machines.DeploymentTarget, error) {
+	deploymentTargets, err := getDeploymentTargetsByID(ctx, client, spaceID, getSliceFromTerraformTypeList(d.Get("machine_ids")))
+	if err != nil {
+		return nil, err
+	}
+
+	environments := getSliceFromTerraformTypeList(d.Get("environments"))
+	roles := getSliceFromTerraformTypeList(d.Get("roles"))
+	if len(environments) > 0 || len(roles) > 0 {
+		matchingDeploymentTargets, err := getDeploymentTargets(ctx, client, spaceID, machines.MachinesQuery{
+			EnvironmentIDs: environments,
+			Roles:          roles,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		deploymentTargets = append(deploymentTargets, matchingDeploymentTargets...)
+	}
+
+	uniqueDeploymentTargets := []*machines.DeploymentTarget{}
+	machineIDs := map[string]bool{}
+	for _, deploymentTarget := range deploymentTargets {
+		if !machineIDs[deploymentTarget.GetID()] {
+			machineIDs[deploymentTarget.GetID()] = true
+			uniqueDeploymentTargets = append(uniqueDeploymentTargets, deploymentTarget)
+		}
+	}
+
+	return uniqueDeploymentTargets, nil
+}
+
+// getDeploymentTargetsByID returns the deployment targets that match the input IDs. The IDs of deployment targets that
+// no longer exist are ignored.
+func getDeploymentTargetsByID(ctx context.Context, client *client.Client, spaceID string, machineIDs []string) ([]*machines.DeploymentTarget, error) {
+	deploymentTargets := []*machines.DeploymentTarget{}
+	for start := 0; start < len(machineIDs); start += tentacleUpgradeBatchSize {
+		end := start + tentacleUpgradeBatchSize
+		if end > len(machineIDs) {
+			end = len(machineIDs)
+		}
+
+		batch, err := getDeploymentTargets(ctx, client, spaceID, machines.MachinesQuery{IDs: machineIDs[start:end]})
+		if err != nil {
+			return nil, err
+		}
+
+		deploymentTargets = append(deploymentTargets, batch...)
+	}
+
+	return deploymentTargets, nil
+}
+
+func getDeploymentTargets(ctx context.Context, client *client.Client, spaceID string, query machines.MachinesQuery) ([]*machines.DeploymentTarget, error) {
+	page, err := machines.Get(client, spaceID, query)
+	if err != nil {
+		return nil, err
+	}
+
+	nextDeploymentTargets, err := getNextPages[*machines.DeploymentTarget](ctx, client, page.PagedResults)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(page.Items, nextDeploymentTargets...), nil
+}
@@ -14,15 +14,20 @@ import (
 func resourceTokenAccount() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceTokenAccountCreate,
+		CustomizeDiff: customizeTenantTagsDiff,
 		DeleteContext: resourceTokenAccountDelete,
 		Importer:      getImporter(),
 		ReadContext:   resourceTokenAccountRead,
-		Schema:        getTokenAccountSchema(),
+		Schema:        addTenantTagSchema(getTokenAccountSchema()),
 		UpdateContext: resourceTokenAccountUpdate,
 	}
 }
 
 func resourceTokenAccountCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateResourceTenantTags(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
 	account := expandTokenAccount(d)
 
 	log.Printf("[INFO] creating token account: %#v", account)
@@ -75,6 +80,10 @@ func resourceTokenAccountRead(ctx context.Context, d *schema.ResourceData, m int
 }
 
 func resourceTokenAccountUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateResourceTenantTags(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
 	account := expandTokenAccount(d)
 
 	log.Printf("[INFO] updating token account: %#v", account)
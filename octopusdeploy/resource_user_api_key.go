@@ -0,0 +1,124 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceUserAPIKey() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUserAPIKeyCreate,
+		CustomizeDiff: resourceUserAPIKeyCustomizeDiff,
+		DeleteContext: resourceUserAPIKeyDelete,
+		Description:   "This resource manages API keys of users and service accounts in Octopus Deploy. The API key is revoked when the resource is destroyed.",
+		Importer:      &schema.ResourceImporter{StateContext: resourceUserAPIKeyImporter},
+		ReadContext:   resourceUserAPIKeyRead,
+		Schema:        getUserAPIKeySchema(),
+		UpdateContext: resourceUserAPIKeyUpdate,
+	}
+}
+
+func resourceUserAPIKeyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	apiKey := expandUserAPIKey(d)
+
+	log.Printf("[INFO] creating API key for user (%s)", apiKey.UserID)
+
+	client := m.(*client.Client)
+	createdAPIKey, err := client.APIKeys.Create(apiKey)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the API key is only ever returned when it is created
+	d.Set("api_key", createdAPIKey.APIKey)
+	if len(createdAPIKey.UserID) == 0 {
+		createdAPIKey.UserID = apiKey.UserID
+	}
+	setUserAPIKey(ctx, d, createdAPIKey)
+
+	log.Printf("[INFO] API key created (%s)", d.Id())
+	return nil
+}
+
+// resourceUserAPIKeyCustomizeDiff plans the replacement of an API key once it expires within its rotation window.
+func resourceUserAPIKeyCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if len(d.Id()) == 0 {
+		return nil
+	}
+
+	expiresAt := d.Get("expires_at").(string)
+	if !isAPIKeyDueForRotation(expiresAt, d.Get("rotation_window_days").(int), time.Now()) {
+		return nil
+	}
+
+	log.Printf("[INFO] API key (%s) expires at %s and is due for rotation", d.Id(), expiresAt)
+
+	// the replacement is created with a new expiry, which is only known once it is created
+	for _, key := range []string{"api_key", "created", "expires_at"} {
+		if err := d.SetNewComputed(key); err != nil {
+			return err
+		}
+	}
+	return d.ForceNew("api_key")
+}
+
+func resourceUserAPIKeyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] revoking API key (%s)", d.Id())
+
+	client := m.(*client.Client)
+	path := fmt.Sprintf("/api/users/%s/apikeys/%s", d.Get("user_id").(string), d.Id())
+	if err := services.ApiDelete(client.Sling(), path); err != nil {
+		return errors.ProcessApiError(ctx, d, err, "API key")
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] API key revoked")
+	return nil
+}
+
+func resourceUserAPIKeyImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	log.Printf("[INFO] importing API key (%s)", d.Id())
+
+	importStrings := strings.Split(d.Id(), ":")
+	if len(importStrings) != 2 {
+		return nil, fmt.Errorf("octopusdeploy_user_api_key import must be in the form of UserID:APIKeyID (e.g. Users-123:APIKeys-123)")
+	}
+
+	d.Set("user_id", importStrings[0])
+	d.SetId(importStrings[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceUserAPIKeyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading API key (%s)", d.Id())
+
+	client := m.(*client.Client)
+	apiKey, err := client.APIKeys.GetByID(d.Get("user_id").(string), d.Id())
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "API key")
+	}
+
+	if len(apiKey.UserID) == 0 {
+		apiKey.UserID = d.Get("user_id").(string)
+	}
+	setUserAPIKey(ctx, d, apiKey)
+
+	log.Printf("[INFO] API key read (%s)", d.Id())
+	return nil
+}
+
+func resourceUserAPIKeyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// only rotation_window_days can change without replacing the API key, and it is not stored on the server
+	return resourceUserAPIKeyRead(ctx, d, m)
+}
@@ -14,16 +14,21 @@ import (
 func resourceUsernamePasswordAccount() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceUsernamePasswordAccountCreate,
+		CustomizeDiff: customizeTenantTagsDiff,
 		DeleteContext: resourceUsernamePasswordAccountDelete,
 		Description:   "This resource manages username-password accounts in Octopus Deploy.",
 		Importer:      getImporter(),
 		ReadContext:   resourceUsernamePasswordAccountRead,
-		Schema:        getUsernamePasswordAccountSchema(),
+		Schema:        addTenantTagSchema(getUsernamePasswordAccountSchema()),
 		UpdateContext: resourceUsernamePasswordAccountUpdate,
 	}
 }
 
 func resourceUsernamePasswordAccountCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateResourceTenantTags(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
 	account := expandUsernamePasswordAccount(d)
 
 	log.Printf("[INFO] creating username-password account: %#v", account)
@@ -76,6 +81,10 @@ func resourceUsernamePasswordAccountRead(ctx context.Context, d *schema.Resource
 }
 
 func resourceUsernamePasswordAccountUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateResourceTenantTags(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
 	account := expandUsernamePasswordAccount(d)
 
 	log.Printf("[INFO] updating username-password account: %#v", account)
@@ -0,0 +1,37 @@
+package octopusdeploy
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/authentication"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var usernamePasswordAuthenticationProvider = &authenticationProvider{
+	configurationID: authentication.UsernamePasswordConfigurationID,
+	expand: func(d *schema.ResourceData) interface{} {
+		return &authentication.UsernamePasswordConfiguration{
+			IsEnabled: d.Get("is_enabled").(bool),
+		}
+	},
+	name: "username/password",
+	read: func(ctx context.Context, d *schema.ResourceData, client *client.Client) error {
+		configuration, err := authentication.GetConfiguration[authentication.UsernamePasswordConfiguration](client, authentication.UsernamePasswordConfigurationID)
+		if err != nil {
+			return err
+		}
+
+		d.Set("is_enabled", configuration.IsEnabled)
+		return nil
+	},
+}
+
+func resourceUsernamePasswordAuthentication() *schema.Resource {
+	return usernamePasswordAuthenticationProvider.resource(
+		"This resource manages the username/password authentication provider of the Octopus Server. Destroying this resource removes it from the state and leaves the provider as it is, unless `disable_on_destroy` is set; ensure that another provider is enabled before setting it.",
+		map[string]*schema.Schema{
+			"is_enabled": getAuthenticationProviderIsEnabledSchema("a username and password"),
+		},
+	)
+}
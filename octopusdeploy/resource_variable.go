@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/url"
 	"strings"
 	"sync"
 
@@ -32,12 +33,15 @@ func resourceVariableImport(d *schema.ResourceData, m interface{}) ([]*schema.Re
 	log.Printf("[INFO] importing variable (%s)", d.Id())
 
 	importStrings := strings.Split(d.Id(), ":")
-	if len(importStrings) != 2 {
-		return nil, fmt.Errorf("octopusdeploy_variable import must be in the form of OwnerID:VariableID (e.g. Projects-62:0906031f-68ba-4a15-afaa-657c1564e07b")
+	if len(importStrings) != 2 && len(importStrings) != 3 {
+		return nil, fmt.Errorf("octopusdeploy_variable import must be in the form of OwnerID:VariableID (e.g. Projects-62:0906031f-68ba-4a15-afaa-657c1564e07b) or ProjectID:GitRef:VariableID for version-controlled projects (e.g. Projects-62:main:0906031f-68ba-4a15-afaa-657c1564e07b)")
 	}
 
 	d.Set("owner_id", importStrings[0])
-	d.SetId(importStrings[1])
+	if len(importStrings) == 3 {
+		d.Set("git_ref", importStrings[1])
+	}
+	d.SetId(importStrings[len(importStrings)-1])
 
 	return []*schema.ResourceData{d}, nil
 }
@@ -75,7 +79,16 @@ func resourceVariableCreate(ctx context.Context, d *schema.ResourceData, m inter
 	log.Printf("[INFO] creating variable: %#v", variable)
 
 	client := m.(*client.Client)
-	variableSet, err := variables.AddSingle(client, spaceID, variableOwnerID, variable)
+	var variableSet variables.VariableSet
+	var err error
+	if gitRef, ok := d.GetOk("git_ref"); ok {
+		variableSet, err = updateVariablesByGitRef(d, client, spaceID, variableOwnerID, gitRef.(string), func(variableSet *variables.VariableSet) error {
+			variableSet.Variables = append(variableSet.Variables, variable)
+			return nil
+		})
+	} else {
+		variableSet, err = variables.AddSingle(client, spaceID, variableOwnerID, variable)
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -124,7 +137,16 @@ func resourceVariableRead(ctx context.Context, d *schema.ResourceData, m interfa
 	}
 
 	client := m.(*client.Client)
-	variable, err := variables.GetByID(client, spaceID, variableOwnerID, id)
+	var variable *variables.Variable
+	var err error
+	if gitRef, ok := d.GetOk("git_ref"); ok {
+		variable, err = getVariableByGitRef(client, spaceID, variableOwnerID, gitRef.(string), id)
+		if err == nil && variable == nil {
+			return errors.DeleteFromState(ctx, d, "variable")
+		}
+	} else {
+		variable, err = variables.GetByID(client, spaceID, variableOwnerID, id)
+	}
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "variable")
 	}
@@ -170,7 +192,21 @@ func resourceVariableUpdate(ctx context.Context, d *schema.ResourceData, m inter
 	}
 
 	client := m.(*client.Client)
-	variableSet, err := variables.UpdateSingle(client, spaceID, variableOwnerID, variable)
+	var variableSet variables.VariableSet
+	var err error
+	if gitRef, ok := d.GetOk("git_ref"); ok {
+		variableSet, err = updateVariablesByGitRef(d, client, spaceID, variableOwnerID, gitRef.(string), func(variableSet *variables.VariableSet) error {
+			for i, v := range variableSet.Variables {
+				if v.ID == variable.ID {
+					variableSet.Variables[i] = variable
+					return nil
+				}
+			}
+			return fmt.Errorf("unable to locate variable (%s) at git reference %s", variable.ID, gitRef)
+		})
+	} else {
+		variableSet, err = variables.UpdateSingle(client, spaceID, variableOwnerID, variable)
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -219,7 +255,21 @@ func resourceVariableDelete(ctx context.Context, d *schema.ResourceData, m inter
 	}
 
 	client := m.(*client.Client)
-	_, err := variables.DeleteSingle(client, spaceID, variableOwnerID, d.Id())
+	var err error
+	if gitRef, ok := d.GetOk("git_ref"); ok {
+		_, err = updateVariablesByGitRef(d, client, spaceID, variableOwnerID, gitRef.(string), func(variableSet *variables.VariableSet) error {
+			remainingVariables := []*variables.Variable{}
+			for _, v := range variableSet.Variables {
+				if v.ID != d.Id() {
+					remainingVariables = append(remainingVariables, v)
+				}
+			}
+			variableSet.Variables = remainingVariables
+			return nil
+		})
+	} else {
+		_, err = variables.DeleteSingle(client, spaceID, variableOwnerID, d.Id())
+	}
 	if err != nil {
 		return errors.ProcessApiError(ctx, d, err, "variable")
 	}
@@ -236,13 +286,96 @@ func validateVariable(d *schema.ResourceData) error {
 	tfSensitive := d.Get("is_sensitive").(bool)
 	tfType := d.Get("type").(string)
 
-	if tfSensitive && tfType != "Sensitive" {
+	if err := validateVariableSensitivity(tfSensitive, tfType); err != nil {
+		return err
+	}
+
+	if _, ok := d.GetOk("git_ref"); ok && tfSensitive {
+		return fmt.Errorf("sensitive variables are not stored in version control, so git_ref cannot be set when is_sensitive is true")
+	}
+
+	return nil
+}
+
+func validateVariableSensitivity(isSensitive bool, variableType string) error {
+	if isSensitive && variableType != "Sensitive" {
 		return fmt.Errorf("when is_sensitive is set to true, type needs to be 'Sensitive'")
 	}
 
-	if !tfSensitive && tfType == "Sensitive" {
+	if !isSensitive && variableType == "Sensitive" {
 		return fmt.Errorf("when type is set to 'Sensitive', is_sensitive needs to be true")
 	}
 
 	return nil
 }
+
+// getVariableByGitRef returns the variable with the given ID from a branch of a version-controlled project, or nil
+// if it does not exist.
+func getVariableByGitRef(client *client.Client, spaceID string, projectID string, gitRef string, id string) (*variables.Variable, error) {
+	if len(spaceID) == 0 {
+		spaceID = client.GetSpaceID()
+	}
+
+	variableSet, err := client.ProjectVariables.GetAllByGitRef(spaceID, projectID, gitRef)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, variable := range variableSet.Variables {
+		if variable.ID == id {
+			return variable, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// updateVariablesByGitRef performs a read-modify-write of the variables stored on a branch of a version-controlled
+// project. The change is committed to the branch and the resulting commit is recorded.
+func updateVariablesByGitRef(d *schema.ResourceData, client *client.Client, spaceID string, projectID string, gitRef string, modify func(*variables.VariableSet) error) (variables.VariableSet, error) {
+	if len(spaceID) == 0 {
+		spaceID = client.GetSpaceID()
+	}
+
+	project, err := client.Projects.GetByID(projectID)
+	if err != nil {
+		return variables.VariableSet{}, err
+	}
+
+	gitPersistenceSettings := getGitPersistenceSettings(project)
+	if gitPersistenceSettings == nil {
+		return variables.VariableSet{}, fmt.Errorf("git_ref can only be set for variables of projects that are stored in version control")
+	}
+
+	if err := validateGitRefCommit(d, gitPersistenceSettings, gitRef); err != nil {
+		return variables.VariableSet{}, err
+	}
+
+	variableSet, err := client.ProjectVariables.GetAllByGitRef(spaceID, projectID, gitRef)
+	if err != nil {
+		return variables.VariableSet{}, err
+	}
+
+	if err := modify(variableSet); err != nil {
+		return variables.VariableSet{}, err
+	}
+
+	path := fmt.Sprintf("/api/%s/projects/%s/%s/variables", spaceID, projectID, url.PathEscape(gitRef))
+	if err := commitVersionControlledResource(client, path, variableSet, getGitCommitMessage(d, "variable"), new(variables.VariableSet)); err != nil {
+		return variables.VariableSet{}, err
+	}
+
+	// the variable set is retrieved again as the response to the update does not include scopes
+	updatedVariableSet, err := client.ProjectVariables.GetAllByGitRef(spaceID, projectID, gitRef)
+	if err != nil {
+		return variables.VariableSet{}, err
+	}
+
+	gitCommit, err := getGitCommit(client, spaceID, projectID, gitRef)
+	if err != nil {
+		log.Printf("[WARN] unable to determine the commit for variables of project (%s): %s", projectID, err)
+	}
+	d.Set("git_commit", gitCommit)
+
+	return *updatedVariableSet, nil
+}
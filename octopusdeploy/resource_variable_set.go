@@ -0,0 +1,137 @@
+package octopusdeploy
+
+import (
+	"context"
+	"log"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/variables"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/errors"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceVariableSet() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVariableSetCreate,
+		DeleteContext: resourceVariableSetDelete,
+		Description:   "This resource manages the complete collection of variables of a project or library variable set in Octopus Deploy. All variables are written in a single request, and changes made outside of Terraform since the last refresh cause the apply to fail rather than be overwritten. Do not use this resource together with `octopusdeploy_variable` for the same owner.",
+		Importer:      getImporter(),
+		ReadContext:   resourceVariableSetRead,
+		Schema:        getVariableSetSchema(),
+		UpdateContext: resourceVariableSetUpdate,
+	}
+}
+
+func resourceVariableSetCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ownerID := d.Get("owner_id").(string)
+
+	log.Printf("[INFO] creating variable set (%s)", ownerID)
+
+	client := m.(*client.Client)
+	existingVariableSet, err := variables.GetAll(client, d.Get("space_id").(string), ownerID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the owner's existing variables are taken over by this resource
+	updatedVariableSet, diags := applyVariableSet(d, client, existingVariableSet)
+	if diags.HasError() {
+		return diags
+	}
+
+	d.SetId(ownerID)
+
+	if err := setVariableSet(ctx, d, updatedVariableSet); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] variable set created (%s)", d.Id())
+	return nil
+}
+
+func resourceVariableSetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] deleting variable set (%s)", d.Id())
+
+	spaceID := d.Get("space_id").(string)
+
+	client := m.(*client.Client)
+	variableSet, err := variables.GetAll(client, spaceID, d.Id())
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "variable set")
+	}
+
+	variableSet.Variables = []*variables.Variable{}
+	if _, err := variables.Update(client, spaceID, d.Id(), variableSet); err != nil {
+		return errors.ProcessApiError(ctx, d, err, "variable set")
+	}
+
+	log.Printf("[INFO] variable set deleted (%s)", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func resourceVariableSetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading variable set (%s)", d.Id())
+
+	client := m.(*client.Client)
+	variableSet, err := variables.GetAll(client, d.Get("space_id").(string), d.Id())
+	if err != nil {
+		return errors.ProcessApiError(ctx, d, err, "variable set")
+	}
+
+	if err := setVariableSet(ctx, d, &variableSet); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] variable set read (%s)", d.Id())
+	return nil
+}
+
+func resourceVariableSetUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] updating variable set (%s)", d.Id())
+
+	client := m.(*client.Client)
+	existingVariableSet, err := variables.GetAll(client, d.Get("space_id").(string), d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if expectedVersion := d.Get("version").(int); int(existingVariableSet.Version) != expectedVersion {
+		return diag.Errorf("the variables of %s have been modified outside of Terraform (version %d, expected version %d); refresh and plan again to review the changes", d.Id(), existingVariableSet.Version, expectedVersion)
+	}
+
+	updatedVariableSet, diags := applyVariableSet(d, client, existingVariableSet)
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := setVariableSet(ctx, d, updatedVariableSet); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] variable set updated (%s)", d.Id())
+	return nil
+}
+
+// applyVariableSet replaces the variables of an existing variable set with the configured ones in a single request.
+// The version of the existing variable set is sent with the request so that the server rejects it if the variables
+// have been changed in the meantime.
+func applyVariableSet(d *schema.ResourceData, client *client.Client, existingVariableSet variables.VariableSet) (*variables.VariableSet, diag.Diagnostics) {
+	desiredVariables, err := expandVariableSetVariables(d.Get("variable").([]interface{}))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	setVariableSetVariableIDs(desiredVariables, existingVariableSet.Variables)
+
+	variableSet := existingVariableSet
+	variableSet.Variables = desiredVariables
+
+	updatedVariableSet, err := variables.Update(client, d.Get("space_id").(string), d.Get("owner_id").(string), variableSet)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	return &updatedVariableSet, nil
+}
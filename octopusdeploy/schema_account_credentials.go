@@ -0,0 +1,119 @@
+package octopusdeploy
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const accountSecretHashPrefix = "sha256:"
+
+// addAccountCredentialSchema adds the credential rotation and modification attributes to the schema of an account
+// resource. The secret attributes identified by the input keys become write-only; only a hash of their values is
+// stored in the state.
+func addAccountCredentialSchema(accountSchema map[string]*schema.Schema, secretKeys ...string) map[string]*schema.Schema {
+	for _, key := range secretKeys {
+		secretSchema := accountSchema[key]
+		secretSchema.Description = strings.TrimSpace(secretSchema.Description + " This value is write-only; only a SHA-256 hash of it is stored in the state.")
+		secretSchema.StateFunc = hashAccountSecret
+	}
+
+	accountSchema["credential_version"] = getCredentialVersionSchema()
+	accountSchema["last_modified_by"] = getLastModifiedBySchema()
+	accountSchema["last_modified_on"] = getLastModifiedOnSchema()
+
+	return accountSchema
+}
+
+func getCredentialVersionSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "An arbitrary value that, when changed, re-sends the secrets of this account to Octopus Deploy. Use it to rotate credentials or to restore secrets that were changed outside of Terraform.",
+		Optional:    true,
+		Type:        schema.TypeString,
+	}
+}
+
+func getLastModifiedBySchema() *schema.Schema {
+	return &schema.Schema{
+		Computed:    true,
+		Description: "The user who last modified this resource in Octopus Deploy.",
+		Type:        schema.TypeString,
+	}
+}
+
+func getLastModifiedOnSchema() *schema.Schema {
+	return &schema.Schema{
+		Computed:    true,
+		Description: "The date and time (in RFC3339 format) at which this resource was last modified in Octopus Deploy.",
+		Type:        schema.TypeString,
+	}
+}
+
+// hashAccountSecret returns the value that is stored in the state for a write-only secret.
+func hashAccountSecret(v interface{}) string {
+	secret, _ := v.(string)
+	if len(secret) == 0 || isAccountSecretHash(secret) {
+		return secret
+	}
+
+	sum := sha256.Sum256([]byte(secret))
+	return accountSecretHashPrefix + hex.EncodeToString(sum[:])
+}
+
+func isAccountSecretHash(v string) bool {
+	return strings.HasPrefix(v, accountSecretHashPrefix) && len(v) == len(accountSecretHashPrefix)+sha256.Size*2
+}
+
+// getAccountSecret returns the plaintext value of a write-only secret from the configuration.
+func getAccountSecret(d *schema.ResourceData, key string) string {
+	if config := d.GetRawConfig(); !config.IsNull() && config.IsKnown() {
+		if v := config.GetAttr(key); !v.IsNull() && v.IsKnown() {
+			return v.AsString()
+		}
+		return ""
+	}
+
+	if v := d.Get(key).(string); !isAccountSecretHash(v) {
+		return v
+	}
+
+	return ""
+}
+
+// expandAccountSecret returns the sensitive value of a write-only secret to send to Octopus Deploy. The secret is only
+// sent when the account is created, when the secret changes or when the credential version changes; otherwise, the
+// value stored on the server is kept.
+func expandAccountSecret(d *schema.ResourceData, key string) *core.SensitiveValue {
+	if len(d.Id()) == 0 || d.HasChange(key) || d.HasChange("credential_version") {
+		return core.NewSensitiveValue(getAccountSecret(d, key))
+	}
+
+	if len(d.Get(key).(string)) == 0 {
+		return core.NewSensitiveValue("")
+	}
+
+	return &core.SensitiveValue{HasValue: true}
+}
+
+func setAccountModification(d *schema.ResourceData, account resources.IResource) error {
+	if err := d.Set("last_modified_by", account.GetModifiedBy()); err != nil {
+		return fmt.Errorf("error setting last_modified_by: %s", err)
+	}
+
+	lastModifiedOn := ""
+	if modifiedOn := account.GetModifiedOn(); modifiedOn != nil {
+		lastModifiedOn = modifiedOn.Format(time.RFC3339)
+	}
+
+	if err := d.Set("last_modified_on", lastModifiedOn); err != nil {
+		return fmt.Errorf("error setting last_modified_on: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package octopusdeploy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/accounts"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashAccountSecret(t *testing.T) {
+	hash := hashAccountSecret("secret")
+	require.True(t, isAccountSecretHash(hash))
+	require.NotContains(t, hash, "secret")
+	require.Equal(t, hash, hashAccountSecret("secret"))
+	require.Equal(t, hash, hashAccountSecret(hash))
+	require.NotEqual(t, hash, hashAccountSecret("other"))
+	require.Equal(t, "", hashAccountSecret(""))
+	require.False(t, isAccountSecretHash("sha256:secret"))
+}
+
+func TestAddAccountCredentialSchema(t *testing.T) {
+	accountSchema := getTokenAccountSchema()
+	require.NotNil(t, accountSchema["token"].StateFunc)
+	require.True(t, accountSchema["token"].Sensitive)
+	require.True(t, accountSchema["credential_version"].Optional)
+	require.True(t, accountSchema["last_modified_by"].Computed)
+	require.True(t, accountSchema["last_modified_on"].Computed)
+	require.NoError(t, schema.InternalMap(accountSchema).InternalValidate(nil))
+}
+
+func TestExpandAccountSecret(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, getSSHKeyAccountSchema(), map[string]interface{}{
+		"name":             "ssh-key",
+		"private_key_file": "private-key",
+		"username":         "octopus",
+	})
+
+	account := expandSSHKeyAccount(d)
+	require.True(t, account.PrivateKeyFile.HasValue)
+	require.Equal(t, "private-key", *account.PrivateKeyFile.NewValue)
+	require.False(t, account.PrivateKeyPassphrase.HasValue)
+}
+
+func TestSetAccountModification(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, getTokenAccountSchema(), map[string]interface{}{})
+
+	modifiedOn := time.Date(2023, 4, 5, 6, 7, 8, 0, time.UTC)
+	account, _ := accounts.NewTokenAccount("token", core.NewSensitiveValue("token"))
+	account.SetModifiedBy("users-1")
+	account.SetModifiedOn(&modifiedOn)
+
+	require.NoError(t, setAccountModification(d, account))
+	require.Equal(t, "users-1", d.Get("last_modified_by"))
+	require.Equal(t, "2023-04-05T06:07:08Z", d.Get("last_modified_on"))
+}
@@ -1,6 +1,8 @@
 package octopusdeploy
 
 import (
+	"time"
+
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/accounts"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -15,6 +17,7 @@ func flattenAccountResource(accountResource *accounts.AccountResource) map[strin
 		"description":                       accountResource.Description,
 		"environments":                      accountResource.EnvironmentIDs,
 		"id":                                accountResource.GetID(),
+		"last_modified_by":                  accountResource.GetModifiedBy(),
 		"name":                              accountResource.Name,
 		"space_id":                          accountResource.SpaceID,
 		"resource_manager_endpoint":         accountResource.ResourceManagerEndpoint,
@@ -24,6 +27,10 @@ func flattenAccountResource(accountResource *accounts.AccountResource) map[strin
 		"username":                          accountResource.Username,
 	}
 
+	if modifiedOn := accountResource.GetModifiedOn(); modifiedOn != nil {
+		flattenedAccountResource["last_modified_on"] = modifiedOn.Format(time.RFC3339)
+	}
+
 	if applicationID := accountResource.ApplicationID; applicationID != nil {
 		flattenedAccountResource["application_id"] = applicationID.String()
 	}
@@ -55,6 +62,7 @@ func getAccountResourceDataSchema() map[string]*schema.Schema {
 		"space_id":     getQuerySpaceID(),
 		"ids":          getQueryIDs(),
 		"partial_name": getQueryPartialName(),
+		"all_pages":    getQueryAllPages(),
 		"skip":         getQuerySkip(),
 		"take":         getQueryTake(),
 	}
@@ -89,6 +97,8 @@ func getAccountResourceSchema() map[string]*schema.Schema {
 		"description":               getDescriptionSchema("account resource"),
 		"environments":              getEnvironmentsSchema(),
 		"id":                        getIDSchema(),
+		"last_modified_by":          getLastModifiedBySchema(),
+		"last_modified_on":          getLastModifiedOnSchema(),
 		"name":                      getNameSchema(true),
 		"password":                  getPasswordSchema(false),
 		"resource_manager_endpoint": getResourceManagerEndpointSchema(false),
@@ -13,7 +13,7 @@ import (
 func expandAmazonWebServicesAccount(d *schema.ResourceData) *accounts.AmazonWebServicesAccount {
 	name := d.Get("name").(string)
 	accessKey := d.Get("access_key").(string)
-	secretKey := core.NewSensitiveValue(d.Get("secret_key").(string))
+	secretKey := expandAccountSecret(d, "secret_key")
 
 	account, _ := accounts.NewAmazonWebServicesAccount(name, accessKey, secretKey)
 	account.ID = d.Id()
@@ -46,7 +46,7 @@ func expandAmazonWebServicesAccount(d *schema.ResourceData) *accounts.AmazonWebS
 }
 
 func getAmazonWebServicesAccountSchema() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
+	return addAccountCredentialSchema(map[string]*schema.Schema{
 		"access_key": {
 			Description: "The access key associated with this AWS account.",
 			Required:    true,
@@ -69,7 +69,7 @@ func getAmazonWebServicesAccountSchema() map[string]*schema.Schema {
 		"tenanted_deployment_participation": getTenantedDeploymentSchema(),
 		"tenants":                           getTenantsSchema(),
 		"tenant_tags":                       getTenantTagsSchema(),
-	}
+	}, "secret_key")
 }
 
 func setAmazonWebServicesAccount(ctx context.Context, d *schema.ResourceData, account *accounts.AmazonWebServicesAccount) error {
@@ -91,5 +91,9 @@ func setAmazonWebServicesAccount(ctx context.Context, d *schema.ResourceData, ac
 		return fmt.Errorf("error setting tenant_tags: %s", err)
 	}
 
+	if err := setAccountModification(d, account); err != nil {
+		return err
+	}
+
 	return nil
 }
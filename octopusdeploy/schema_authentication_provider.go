@@ -0,0 +1,161 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/authentication"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// authenticationProvider describes the server configuration section of an authentication provider. Authentication
+// providers always exist on the server, so creating the resource updates the configuration and destroying the resource
+// only disables the provider when disable_on_destroy is set, since disabling the only enabled provider locks every user
+// out of the server.
+type authenticationProvider struct {
+	configurationID string
+	expand          func(d *schema.ResourceData) interface{}
+	name            string
+	read            func(ctx context.Context, d *schema.ResourceData, client *client.Client) error
+}
+
+func (p *authenticationProvider) resource(description string, providerSchema map[string]*schema.Schema) *schema.Resource {
+	providerSchema["disable_on_destroy"] = &schema.Schema{
+		Default:     false,
+		Description: fmt.Sprintf("Indicates whether destroying this resource disables the %s authentication provider. Otherwise the provider is left as it is.", p.name),
+		Optional:    true,
+		Type:        schema.TypeBool,
+	}
+
+	return &schema.Resource{
+		CreateContext: p.update,
+		DeleteContext: p.delete,
+		Description:   description,
+		Importer:      getImporter(),
+		ReadContext:   p.readContext,
+		Schema:        providerSchema,
+		UpdateContext: p.update,
+	}
+}
+
+func (p *authenticationProvider) delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if !d.Get("disable_on_destroy").(bool) {
+		log.Printf("[INFO] removing %s authentication provider (%s) from state without disabling it", p.name, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	log.Printf("[INFO] disabling %s authentication provider (%s)", p.name, d.Id())
+
+	client := m.(*client.Client)
+	if err := authentication.UpdateConfiguration(client, p.configurationID, map[string]interface{}{"IsEnabled": false}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	log.Printf("[INFO] %s authentication provider disabled", p.name)
+	return nil
+}
+
+func (p *authenticationProvider) readContext(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] reading %s authentication provider (%s)", p.name, d.Id())
+
+	client := m.(*client.Client)
+	if err := p.read(ctx, d, client); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(p.configurationID)
+
+	log.Printf("[INFO] %s authentication provider read (%s)", p.name, d.Id())
+	return nil
+}
+
+func (p *authenticationProvider) update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	configuration := p.expand(d)
+
+	log.Printf("[INFO] updating %s authentication provider (%s)", p.name, p.configurationID)
+
+	client := m.(*client.Client)
+	if err := authentication.UpdateConfiguration(client, p.configurationID, configuration); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(p.configurationID)
+
+	log.Printf("[INFO] %s authentication provider updated (%s)", p.name, d.Id())
+	return p.readContext(ctx, d, m)
+}
+
+func expandOpenIDConnectSettings(d *schema.ResourceData) authentication.OpenIDConnectSettings {
+	return authentication.OpenIDConnectSettings{
+		AllowAutoUserCreation: d.Get("allow_auto_user_creation").(bool),
+		ClientID:              d.Get("client_id").(string),
+		ClientSecret:          expandChangedSensitiveValue(d, "client_secret"),
+		IsEnabled:             d.Get("is_enabled").(bool),
+		Issuer:                d.Get("issuer").(string),
+		RoleClaimType:         d.Get("role_claim_type").(string),
+	}
+}
+
+func getAllowAutoUserCreationSchema(providerName string) *schema.Schema {
+	return &schema.Schema{
+		Default:     true,
+		Description: fmt.Sprintf("Indicates whether users who sign in with %s are created automatically if they do not exist.", providerName),
+		Optional:    true,
+		Type:        schema.TypeBool,
+	}
+}
+
+func getAuthenticationProviderIsEnabledSchema(providerName string) *schema.Schema {
+	return &schema.Schema{
+		Default:     true,
+		Description: fmt.Sprintf("Indicates whether users can sign in with %s.", providerName),
+		Optional:    true,
+		Type:        schema.TypeBool,
+	}
+}
+
+func getOpenIDConnectSettingsSchema(providerName string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"allow_auto_user_creation": getAllowAutoUserCreationSchema(providerName),
+		"client_id": {
+			Description:      fmt.Sprintf("The client ID of the application registered with %s.", providerName),
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+		},
+		"client_secret": {
+			Description: fmt.Sprintf("The client secret of the application registered with %s. The server never returns the client secret, so changes made outside of Terraform are not detected.", providerName),
+			Optional:    true,
+			Sensitive:   true,
+			Type:        schema.TypeString,
+		},
+		"is_enabled": getAuthenticationProviderIsEnabledSchema(providerName),
+		"issuer": {
+			Description:      fmt.Sprintf("The URL of the %s issuer.", providerName),
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IsURLWithHTTPS),
+		},
+		"role_claim_type": {
+			Computed:    true,
+			Description: "The type of the claim that contains the roles (or groups) of a user. The values of this claim can be used as external security groups of teams.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+	}
+}
+
+func setOpenIDConnectSettings(d *schema.ResourceData, settings authentication.OpenIDConnectSettings) {
+	d.Set("allow_auto_user_creation", settings.AllowAutoUserCreation)
+	d.Set("client_id", settings.ClientID)
+	d.Set("is_enabled", settings.IsEnabled)
+	d.Set("issuer", settings.Issuer)
+	d.Set("role_claim_type", settings.RoleClaimType)
+}
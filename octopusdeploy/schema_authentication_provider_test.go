@@ -0,0 +1,74 @@
+package octopusdeploy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/authentication"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/serverconfiguration"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandOpenIDConnectSettings(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, getOpenIDConnectSettingsSchema("Okta"), map[string]interface{}{
+		"client_id":       "client",
+		"client_secret":   "secret",
+		"issuer":          "https://example.okta.com",
+		"role_claim_type": "groups",
+	})
+
+	settings := expandOpenIDConnectSettings(d)
+	require.True(t, settings.AllowAutoUserCreation)
+	require.True(t, settings.IsEnabled)
+	require.Equal(t, "client", settings.ClientID)
+	require.Equal(t, "https://example.okta.com", settings.Issuer)
+	require.Equal(t, "groups", settings.RoleClaimType)
+	require.NotNil(t, settings.ClientSecret)
+	require.True(t, settings.ClientSecret.HasValue)
+	require.Equal(t, "secret", *settings.ClientSecret.NewValue)
+
+	d = schema.TestResourceDataRaw(t, getOpenIDConnectSettingsSchema("Okta"), map[string]interface{}{
+		"client_id": "client",
+		"issuer":    "https://example.okta.com",
+	})
+	require.Nil(t, expandOpenIDConnectSettings(d).ClientSecret)
+}
+
+func TestMergeAuthenticationConfigurationValues(t *testing.T) {
+	values := map[string]interface{}{
+		"ClientSecret":  map[string]interface{}{"HasValue": true},
+		"IsEnabled":     false,
+		"RoleClaimType": "roles",
+		"Unmanaged":     "unchanged",
+	}
+
+	configuration := &authentication.OktaConfiguration{
+		OpenIDConnectSettings: authentication.OpenIDConnectSettings{
+			ClientID:  "client",
+			IsEnabled: true,
+			Issuer:    "https://example.okta.com",
+		},
+	}
+
+	require.NoError(t, serverconfiguration.MergeValues(values, configuration))
+	require.Equal(t, true, values["IsEnabled"])
+	require.Equal(t, "client", values["ClientId"])
+	require.Equal(t, "roles", values["RoleClaimType"])
+	require.Equal(t, "unchanged", values["Unmanaged"])
+	require.Equal(t, map[string]interface{}{"HasValue": true}, values["ClientSecret"])
+}
+
+func TestAuthenticationProviderDeleteLeavesProviderEnabled(t *testing.T) {
+	resource := resourceUsernamePasswordAuthentication()
+	require.Contains(t, resource.Schema, "disable_on_destroy")
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"is_enabled": true,
+	})
+	d.SetId(authentication.UsernamePasswordConfigurationID)
+
+	// without disable_on_destroy, the server is not called
+	require.False(t, usernamePasswordAuthenticationProvider.delete(context.Background(), d, nil).HasError())
+	require.Empty(t, d.Id())
+}
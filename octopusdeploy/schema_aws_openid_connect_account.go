@@ -0,0 +1,46 @@
+package octopusdeploy
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/oidcaccounts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func expandAmazonWebServicesOpenIDConnectAccount(d *schema.ResourceData) *oidcaccounts.AmazonWebServicesOIDCAccount {
+	name := d.Get("name").(string)
+	roleArn := d.Get("role_arn").(string)
+
+	account := oidcaccounts.NewAmazonWebServicesOIDCAccount(name, roleArn)
+	account.SessionDuration = d.Get("session_duration").(int)
+
+	expandOpenIDConnectAccount(d, &account.Account)
+
+	return account
+}
+
+func getAmazonWebServicesOpenIDConnectAccountSchema() map[string]*schema.Schema {
+	return getOpenIDConnectAccountSchema("AWS OpenID Connect account", map[string]*schema.Schema{
+		"role_arn": {
+			Description:      "The Amazon Resource Name (ARN) of the IAM role that is assumed with the OIDC tokens issued by Octopus Deploy.",
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"session_duration": {
+			Default:          oidcaccounts.DefaultSessionDuration,
+			Description:      "The duration, in seconds, of the role session.",
+			Optional:         true,
+			Type:             schema.TypeInt,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(900, 43200)),
+		},
+	})
+}
+
+func setAmazonWebServicesOpenIDConnectAccount(ctx context.Context, d *schema.ResourceData, account *oidcaccounts.AmazonWebServicesOIDCAccount) error {
+	d.Set("role_arn", account.RoleArn)
+	d.Set("session_duration", account.SessionDuration)
+
+	return setOpenIDConnectAccount(d, &account.Account)
+}
@@ -0,0 +1,65 @@
+package octopusdeploy
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/oidcaccounts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func expandAzureOpenIDConnectAccount(d *schema.ResourceData) *oidcaccounts.AzureOIDCAccount {
+	name := d.Get("name").(string)
+	applicationID := d.Get("application_id").(string)
+	subscriptionID := d.Get("subscription_id").(string)
+	tenantID := d.Get("tenant_id").(string)
+
+	account := oidcaccounts.NewAzureOIDCAccount(name, subscriptionID, tenantID, applicationID)
+	account.Audience = d.Get("audience").(string)
+
+	if v, ok := d.GetOk("authentication_endpoint"); ok {
+		account.AuthenticationEndpoint = v.(string)
+	}
+
+	if v, ok := d.GetOk("azure_environment"); ok {
+		account.AzureEnvironment = v.(string)
+	}
+
+	if v, ok := d.GetOk("resource_manager_endpoint"); ok {
+		account.ResourceManagerEndpoint = v.(string)
+	}
+
+	expandOpenIDConnectAccount(d, &account.Account)
+
+	return account
+}
+
+func getAzureOpenIDConnectAccountSchema() map[string]*schema.Schema {
+	return getOpenIDConnectAccountSchema("Azure OpenID Connect account", map[string]*schema.Schema{
+		"application_id": getApplicationIDSchema(true),
+		"audience": {
+			Default:          oidcaccounts.DefaultAzureAudience,
+			Description:      "The audience of the OIDC tokens that are exchanged with Microsoft Entra ID. It must match the audience of the federated credential of the app registration.",
+			Optional:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"authentication_endpoint":   getAuthenticationEndpointSchema(false),
+		"azure_environment":         getAzureEnvironmentSchema(),
+		"resource_manager_endpoint": getResourceManagerEndpointSchema(false),
+		"subscription_id":           getSubscriptionIDSchema(true),
+		"tenant_id":                 getTenantIDSchema(true),
+	})
+}
+
+func setAzureOpenIDConnectAccount(ctx context.Context, d *schema.ResourceData, account *oidcaccounts.AzureOIDCAccount) error {
+	d.Set("application_id", account.ApplicationID)
+	d.Set("audience", account.Audience)
+	d.Set("authentication_endpoint", account.AuthenticationEndpoint)
+	d.Set("azure_environment", account.AzureEnvironment)
+	d.Set("resource_manager_endpoint", account.ResourceManagerEndpoint)
+	d.Set("subscription_id", account.SubscriptionID)
+	d.Set("tenant_id", account.TenantID)
+
+	return setOpenIDConnectAccount(d, &account.Account)
+}
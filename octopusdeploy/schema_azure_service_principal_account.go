@@ -12,8 +12,7 @@ import (
 
 func expandAzureServicePrincipalAccount(d *schema.ResourceData) *accounts.AzureServicePrincipalAccount {
 	name := d.Get("name").(string)
-	password := d.Get("password").(string)
-	secretKey := core.NewSensitiveValue(password)
+	secretKey := expandAccountSecret(d, "password")
 
 	applicationID, _ := uuid.Parse(d.Get("application_id").(string))
 	tenantID, _ := uuid.Parse(d.Get("tenant_id").(string))
@@ -62,7 +61,7 @@ func expandAzureServicePrincipalAccount(d *schema.ResourceData) *accounts.AzureS
 }
 
 func getAzureServicePrincipalAccountSchema() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
+	return addAccountCredentialSchema(map[string]*schema.Schema{
 		"application_id":                    getApplicationIDSchema(true),
 		"authentication_endpoint":           getAuthenticationEndpointSchema(false),
 		"azure_environment":                 getAzureEnvironmentSchema(),
@@ -78,7 +77,7 @@ func getAzureServicePrincipalAccountSchema() map[string]*schema.Schema {
 		"tenants":                           getTenantsSchema(),
 		"tenant_id":                         getTenantIDSchema(true),
 		"tenant_tags":                       getTenantTagsSchema(),
-	}
+	}, "password")
 }
 
 func setAzureServicePrincipalAccount(ctx context.Context, d *schema.ResourceData, account *accounts.AzureServicePrincipalAccount) error {
@@ -106,5 +105,9 @@ func setAzureServicePrincipalAccount(ctx context.Context, d *schema.ResourceData
 		return fmt.Errorf("error setting tenant_tags: %s", err)
 	}
 
+	if err := setAccountModification(d, account); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -21,8 +21,8 @@ func expandAzureSubscriptionAccount(d *schema.ResourceData) *accounts.AzureSubsc
 		account.AzureEnvironment = v.(string)
 	}
 
-	if v, ok := d.GetOk("certificate"); ok {
-		account.CertificateBytes = core.NewSensitiveValue(v.(string))
+	if certificate := expandAccountSecret(d, "certificate"); certificate.HasValue {
+		account.CertificateBytes = certificate
 	}
 
 	if v, ok := d.GetOk("certificate_thumbprint"); ok {
@@ -74,7 +74,7 @@ func expandAzureSubscriptionAccount(d *schema.ResourceData) *accounts.AzureSubsc
 }
 
 func getAzureSubscriptionAccountSchema() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
+	return addAccountCredentialSchema(map[string]*schema.Schema{
 		"azure_environment": getAzureEnvironmentSchema(),
 		"certificate": {
 			Computed:  true,
@@ -107,7 +107,7 @@ func getAzureSubscriptionAccountSchema() map[string]*schema.Schema {
 		"tenanted_deployment_participation": getTenantedDeploymentSchema(),
 		"tenants":                           getTenantsSchema(),
 		"tenant_tags":                       getTenantTagsSchema(),
-	}
+	}, "certificate")
 }
 
 func setAzureSubscriptionAccount(ctx context.Context, d *schema.ResourceData, account *accounts.AzureSubscriptionAccount) error {
@@ -135,5 +135,9 @@ func setAzureSubscriptionAccount(ctx context.Context, d *schema.ResourceData, ac
 
 	d.SetId(account.GetID())
 
+	if err := setAccountModification(d, account); err != nil {
+		return err
+	}
+
 	return nil
 }
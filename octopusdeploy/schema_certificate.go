@@ -10,11 +10,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// certificateSourceKeys are the attributes that provide the data of a certificate, of which exactly one is set.
+var certificateSourceKeys = []string{"certificate_data", "certificate_pem", "generate"}
+
 func expandCertificate(d *schema.ResourceData) *certificates.CertificateResource {
 	name := d.Get("name").(string)
-	certificateData := core.NewSensitiveValue(d.Get("certificate_data").(string))
 	password := core.NewSensitiveValue(d.Get("password").(string))
 
+	// generated certificates are only uploaded when they are created or replaced, so updates leave their data as is
+	var certificateData *core.SensitiveValue
+	if expandCertificateGeneration(d.Get("generate")) == nil {
+		data, _ := getCertificateData(d)
+		certificateData = core.NewSensitiveValue(data)
+	}
+
 	certificate := certificates.NewCertificateResource(name, certificateData, password)
 	certificate.ID = d.Id()
 
@@ -161,8 +170,7 @@ func flattenCertificate(certificate *certificates.CertificateResource) map[strin
 }
 
 func getCertificateDataSchema() map[string]*schema.Schema {
-	dataSchema := getCertificateSchema()
-	setDataSchema(&dataSchema)
+	dataSchema := getCertificateResultSchema()
 
 	return map[string]*schema.Schema{
 		"archived": getQueryArchived(),
@@ -179,6 +187,7 @@ func getCertificateDataSchema() map[string]*schema.Schema {
 		"order_by":     getQueryOrderBy(),
 		"partial_name": getQueryPartialName(),
 		"search":       getQuerySearch(),
+		"all_pages":    getQueryAllPages(),
 		"skip":         getQuerySkip(),
 		"take":         getQueryTake(),
 		"tenant":       getQueryTenant(),
@@ -186,6 +195,88 @@ func getCertificateDataSchema() map[string]*schema.Schema {
 	}
 }
 
+func getCertificateGenerateSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"common_name": {
+			Description:      "The common name (CN) of the subject of the certificate.",
+			ForceNew:         true,
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"key_algorithm": {
+			Default:     certificateKeyAlgorithmRSA2048,
+			Description: "The algorithm of the private key. Valid algorithms are `ECDSA_P256`, `ECDSA_P384`, `RSA_2048`, and `RSA_4096`. Defaults to `RSA_2048`.",
+			ForceNew:    true,
+			Optional:    true,
+			Type:        schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+				certificateKeyAlgorithmECDSAP256,
+				certificateKeyAlgorithmECDSAP384,
+				certificateKeyAlgorithmRSA2048,
+				certificateKeyAlgorithmRSA4096,
+			}, false)),
+		},
+		"sans": {
+			Description: "The subject alternative names of the certificate. IP addresses are added as IP SANs; other values are added as DNS names.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			ForceNew:    true,
+			Optional:    true,
+			Type:        schema.TypeList,
+		},
+		"validity_days": {
+			Default:          365,
+			Description:      "The number of days that the certificate is valid for. Defaults to `365`.",
+			ForceNew:         true,
+			Optional:         true,
+			Type:             schema.TypeInt,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+		},
+	}
+}
+
+func getExpiringCertificatesDataSchema() map[string]*schema.Schema {
+	dataSchema := getCertificateResultSchema()
+
+	return map[string]*schema.Schema{
+		"certificates": {
+			Computed:    true,
+			Description: "A list of certificates that expire within the duration, ordered by their expiry dates.",
+			Elem:        &schema.Resource{Schema: dataSchema},
+			Type:        schema.TypeList,
+		},
+		"id": getDataSchemaID(),
+		"include_expired": {
+			Default:     true,
+			Description: "Indicates whether certificates that have already expired are included. Defaults to `true`.",
+			Optional:    true,
+			Type:        schema.TypeBool,
+		},
+		"space_id": getSpaceIDSchema(),
+		"within": {
+			Description:      "The duration from now within which the certificates expire (i.e. `720h` for 30 days).",
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validateDuration),
+		},
+	}
+}
+
+// getCertificateResultSchema returns the schema of the certificates that data sources return, without the attributes
+// that only configure the resource.
+func getCertificateResultSchema() map[string]*schema.Schema {
+	resultSchema := getCertificateSchema()
+	setDataSchema(&resultSchema)
+	delete(resultSchema, "certificate_chain_pem")
+	delete(resultSchema, "certificate_pem")
+	delete(resultSchema, "generate")
+	delete(resultSchema, "private_key_pem")
+	delete(resultSchema, "replace_in_place")
+	delete(resultSchema, "warn_before_expiry")
+
+	return resultSchema
+}
+
 func getCertificateSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"archived": {
@@ -193,15 +284,39 @@ func getCertificateSchema() map[string]*schema.Schema {
 			Optional: true,
 			Type:     schema.TypeString,
 		},
+		"certificate_chain_pem": {
+			Description:      "The PEM-encoded certificates that chain the certificate of `certificate_pem` to its root.",
+			Optional:         true,
+			RequiredWith:     []string{"certificate_pem"},
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validatePEMBlocks("CERTIFICATE")),
+		},
 		"certificate_data": {
-			Description:      "The encoded data of the certificate.",
-			Required:         true,
+			Description:      "The base64-encoded data of the certificate (i.e. of a PFX file). Exactly one of `certificate_data`, `certificate_pem` or `generate` must be specified.",
+			ExactlyOneOf:     certificateSourceKeys,
+			Optional:         true,
 			Sensitive:        true,
 			Type:             schema.TypeString,
 			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
 		},
 		"certificate_data_format": getCertificateDataFormatSchema(),
-		"environments":            getEnvironmentsSchema(),
+		"certificate_pem": {
+			Description:      "The PEM-encoded certificate. It is uploaded together with `certificate_chain_pem` and `private_key_pem`, concatenated in that order. Exactly one of `certificate_data`, `certificate_pem` or `generate` must be specified.",
+			ExactlyOneOf:     certificateSourceKeys,
+			Optional:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validatePEMBlocks("CERTIFICATE")),
+		},
+		"environments": getEnvironmentsSchema(),
+		"generate": {
+			Description:  "Generates a self-signed certificate with a new private key. The provider generates both and uploads them as PEM. The private key is not stored in the state. Changing the block generates another certificate, which is created as a new resource. Exactly one of `certificate_data`, `certificate_pem` or `generate` must be specified.",
+			Elem:         &schema.Resource{Schema: getCertificateGenerateSchema()},
+			ExactlyOneOf: certificateSourceKeys,
+			ForceNew:     true,
+			MaxItems:     1,
+			Optional:     true,
+			Type:         schema.TypeList,
+		},
 		"has_private_key": {
 			Computed:    true,
 			Description: "Indicates if the certificate has a private key.",
@@ -246,7 +361,26 @@ func getCertificateSchema() map[string]*schema.Schema {
 			Optional: true,
 			Type:     schema.TypeString,
 		},
-		"password": getPasswordSchema(true),
+		"password": {
+			Description: "The password of the certificate data (i.e. of a PFX file or of an encrypted private key).",
+			Optional:    true,
+			Sensitive:   true,
+			Type:        schema.TypeString,
+		},
+		"private_key_pem": {
+			Description:      "The PEM-encoded private key of the certificate of `certificate_pem`.",
+			Optional:         true,
+			RequiredWith:     []string{"certificate_pem"},
+			Sensitive:        true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validatePEMBlocks("PRIVATE KEY")),
+		},
+		"replace_in_place": {
+			Default:     false,
+			Description: "When set, a change to the data of the certificate (`certificate_data`, `certificate_pem`, `certificate_chain_pem` or `private_key_pem`) replaces the certificate in place instead of updating it. The certificate keeps its ID, so variables that reference it are not affected, and Octopus Deploy archives the previous certificate.",
+			Optional:    true,
+			Type:        schema.TypeBool,
+		},
 		"replaced_by": {
 			Computed: true,
 			Optional: true,
@@ -301,6 +435,12 @@ func getCertificateSchema() map[string]*schema.Schema {
 			Optional: true,
 			Type:     schema.TypeInt,
 		},
+		"warn_before_expiry": {
+			Description:      "When set, reading the certificate (i.e. during a plan) returns a warning once it expires within this duration (i.e. `720h` for 30 days).",
+			Optional:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validateDuration),
+		},
 		"space_id": {
 			Optional: true,
 			Computed: true,
@@ -0,0 +1,129 @@
+package octopusdeploy
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/certificates"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	certs "github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/certificates"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCertificateReplaceInPlaceDiff(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "Certificates-1",
+		Attributes: map[string]string{
+			"certificate_data": "old",
+			"id":               "Certificates-1",
+			"name":             "Web",
+			"not_after":        "2024-06-20T00:00:00+00:00",
+			"password":         "secret",
+			"replace_in_place": "false",
+			"thumbprint":       "OLD",
+		},
+	}
+
+	config := map[string]interface{}{
+		"certificate_data": "new",
+		"name":             "Web",
+		"password":         "secret",
+	}
+
+	diff, err := resourceCertificate().Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+	require.NoError(t, err)
+	require.False(t, diff.RequiresNew())
+	require.NotContains(t, diff.Attributes, "thumbprint")
+
+	state.Attributes["replace_in_place"] = "true"
+	config["replace_in_place"] = true
+
+	diff, err = resourceCertificate().Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+	require.NoError(t, err)
+	require.False(t, diff.RequiresNew())
+	require.True(t, diff.Attributes["thumbprint"].NewComputed)
+	require.True(t, diff.Attributes["not_after"].NewComputed)
+}
+
+func TestCertificateGenerateDiff(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "Certificates-1",
+		Attributes: map[string]string{
+			"generate.#":               "1",
+			"generate.0.common_name":   "dev.example.com",
+			"generate.0.key_algorithm": "RSA_2048",
+			"generate.0.sans.#":        "0",
+			"generate.0.validity_days": "365",
+			"id":                       "Certificates-1",
+			"name":                     "Dev",
+			"replace_in_place":         "false",
+			"thumbprint":               "OLD",
+		},
+	}
+
+	config := map[string]interface{}{
+		"generate": []interface{}{map[string]interface{}{
+			"common_name": "dev.example.com",
+		}},
+		"name": "Dev",
+	}
+
+	diff, err := resourceCertificate().Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+	require.NoError(t, err)
+	require.False(t, diff.RequiresNew())
+	require.NotContains(t, diff.Attributes, "thumbprint")
+
+	config["generate"] = []interface{}{map[string]interface{}{
+		"common_name":   "dev.example.com",
+		"validity_days": 30,
+	}}
+
+	diff, err = resourceCertificate().Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+	require.NoError(t, err)
+	require.True(t, diff.RequiresNew())
+}
+
+func TestCertificateReplaceInSpace(t *testing.T) {
+	requests := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		certificate := certificates.CertificateResource{Name: "Web", SpaceID: "Spaces-2", Thumbprint: "NEW"}
+		certificate.ID = "Certificates-1"
+		if r.Method == http.MethodPost {
+			// the replace endpoint returns the archived certificate
+			certificate.ID = "Certificates-2"
+			certificate.Thumbprint = "OLD"
+
+			var replacementCertificate certificates.ReplacementCertificate
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&replacementCertificate))
+			require.Equal(t, "new", replacementCertificate.CertificateData)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(certificate))
+	}))
+	t.Cleanup(server.Close)
+
+	baseURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	client := newclient.NewClientS(&newclient.HttpSession{
+		BaseURL:    baseURL,
+		HttpClient: server.Client(),
+	}, "Spaces-1")
+
+	certificate, err := certs.Replace(client, "Spaces-2", "Certificates-1", certificates.NewReplacementCertificate("new", "secret"))
+	require.NoError(t, err)
+	require.Equal(t, "Certificates-1", certificate.GetID())
+	require.Equal(t, "NEW", certificate.Thumbprint)
+	require.Equal(t, []string{
+		"POST /api/Spaces-2/certificates/Certificates-1/replace",
+		"GET /api/Spaces-2/certificates/Certificates-1",
+	}, requests)
+}
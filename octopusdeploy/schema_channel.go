@@ -78,6 +78,7 @@ func getChannelDataSchema() map[string]*schema.Schema {
 		},
 		"ids":          getQueryIDs(),
 		"partial_name": getQueryPartialName(),
+		"all_pages":    getQueryAllPages(),
 		"skip":         getQuerySkip(),
 		"take":         getQueryTake(),
 		"space_id":     getSpaceIDSchema(),
@@ -25,6 +25,8 @@ func flattenCloudRegionDeploymentTarget(deploymentTarget *machines.DeploymentTar
 	}
 
 	flattenedDeploymentTarget := flattenDeploymentTarget(deploymentTarget)
+	delete(flattenedDeploymentTarget, "endpoint")
+
 	endpointResource, _ := machines.ToEndpointResource(deploymentTarget.Endpoint)
 	flattenedDeploymentTarget["default_worker_pool_id"] = endpointResource.DefaultWorkerPoolID
 	return flattenedDeploymentTarget
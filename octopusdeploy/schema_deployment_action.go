@@ -13,6 +13,7 @@ import (
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func flattenDeploymentAction(action *deployments.DeploymentAction) map[string]interface{} {
@@ -245,10 +246,15 @@ func getActionSchema() (*schema.Schema, *schema.Resource) {
 				ValidateDiagFunc: warnIfIncludesRunOnServer(),
 			},
 			"sort_order": {
-				Description: "Order used by terraform to ensure correct ordering of actions. This property must be either omitted from all actions, or provided on all actions",
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Default:     -1,
+				Computed:    true,
+				Description: "The position of this action within its step, starting at 1. Used to preserve the order of actions of different types within the same step. When omitted or `0`, the order returned by the server is retained. This property must be either omitted from all actions, or provided on all actions",
+				// a sort_order of 0 is treated as omitted, so it never differs from the position read from the server
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return new == "0"
+				},
+				Optional:         true,
+				Type:             schema.TypeInt,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
 			},
 			"tenant_tags": getTenantTagsSchema(),
 		},
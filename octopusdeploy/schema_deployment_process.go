@@ -15,7 +15,9 @@ func expandDeploymentProcess(ctx context.Context, d *schema.ResourceData, client
 	deploymentProcess := deployments.NewDeploymentProcess(projectID)
 	deploymentProcess.ID = d.Id()
 
-	if v, ok := d.GetOk("branch"); ok {
+	if v, ok := d.GetOk("git_ref"); ok {
+		deploymentProcess.Branch = v.(string)
+	} else if v, ok := d.GetOk("branch"); ok {
 		deploymentProcess.Branch = v.(string)
 	} else {
 		project, err := client.Projects.GetByID(projectID)
@@ -53,6 +55,7 @@ func expandDeploymentProcess(ctx context.Context, d *schema.ResourceData, client
 
 func setDeploymentProcess(ctx context.Context, d *schema.ResourceData, deploymentProcess *deployments.DeploymentProcess) error {
 	d.Set("branch", deploymentProcess.Branch)
+	d.Set("git_ref", deploymentProcess.Branch)
 	d.Set("last_snapshot_id", deploymentProcess.LastSnapshotID)
 	d.Set("project_id", deploymentProcess.ProjectID)
 	d.Set("space_id", deploymentProcess.SpaceID)
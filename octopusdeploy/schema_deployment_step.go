@@ -55,8 +55,9 @@ func expandDeploymentStep(ctx context.Context, flattenedStep map[string]interfac
 				action := step_type_action(flattenedAction)
 				step.Actions = append(step.Actions, action)
 
-				// Pull out the sort_order if it exists. This is used to sort the actions later
-				if posn, ok := flattenedAction["sort_order"].(int); ok && posn >= 0 {
+				// Pull out the sort_order if it exists. This is used to sort the actions later. A value of zero
+				// means the sort_order was neither configured nor previously read from the server.
+				if posn, ok := flattenedAction["sort_order"].(int); ok && posn > 0 {
 					name := flattenedAction["name"].(string)
 					sort_order[name] = posn
 				}
@@ -92,8 +93,14 @@ func expandDeploymentStep(ctx context.Context, flattenedStep map[string]interfac
 			tflog.Warn(ctx, fmt.Sprintf("Not all actions on step '%s' have a `sort_order` parameter so they may be sorted in an unexpected order", step.Name))
 		}
 
+		// Actions without a sort_order are placed after those with one, retaining the order in which they were declared
 		sort.SliceStable(step.Actions, func(i, j int) bool {
-			return sort_order[step.Actions[i].Name] < sort_order[step.Actions[j].Name]
+			left, leftOk := sort_order[step.Actions[i].Name]
+			right, rightOk := sort_order[step.Actions[j].Name]
+			if leftOk != rightOk {
+				return leftOk
+			}
+			return left < right
 		})
 	}
 
@@ -0,0 +1,134 @@
+package octopusdeploy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/stretchr/testify/require"
+)
+
+func getActionNames(actions []*deployments.DeploymentAction) []string {
+	names := []string{}
+	for _, action := range actions {
+		names = append(names, action.Name)
+	}
+	return names
+}
+
+func TestExpandDeploymentStepWithMixedActionTypes(t *testing.T) {
+	flattenedStep := map[string]interface{}{
+		"name": "Rolling Step",
+		"run_script_action": []interface{}{
+			map[string]interface{}{"name": "Script A", "sort_order": 1},
+			map[string]interface{}{"name": "Script B", "sort_order": 4},
+		},
+		"deploy_package_action": []interface{}{
+			map[string]interface{}{"name": "Package", "sort_order": 2},
+		},
+		"action": []interface{}{
+			map[string]interface{}{"name": "Custom", "action_type": "Octopus.Custom", "sort_order": 3},
+		},
+	}
+
+	step := expandDeploymentStep(context.Background(), flattenedStep)
+	require.Equal(t, []string{"Script A", "Package", "Custom", "Script B"}, getActionNames(step.Actions))
+	require.Equal(t, "Octopus.Script", step.Actions[0].ActionType)
+	require.Equal(t, "Octopus.TentaclePackage", step.Actions[1].ActionType)
+	require.Equal(t, "Octopus.Custom", step.Actions[2].ActionType)
+	require.Equal(t, "Octopus.Script", step.Actions[3].ActionType)
+}
+
+func TestExpandDeploymentStepWithPartialSortOrder(t *testing.T) {
+	flattenedStep := map[string]interface{}{
+		"name": "Rolling Step",
+		"action": []interface{}{
+			map[string]interface{}{"name": "Unordered", "action_type": "Octopus.Custom", "sort_order": 0},
+		},
+		"run_script_action": []interface{}{
+			map[string]interface{}{"name": "Second", "sort_order": 2},
+			map[string]interface{}{"name": "First", "sort_order": 1},
+		},
+	}
+
+	step := expandDeploymentStep(context.Background(), flattenedStep)
+	require.Equal(t, []string{"First", "Second", "Unordered"}, getActionNames(step.Actions))
+}
+
+func TestExpandDeploymentStepWithoutSortOrder(t *testing.T) {
+	flattenedStep := map[string]interface{}{
+		"name": "Rolling Step",
+		"run_script_action": []interface{}{
+			map[string]interface{}{"name": "Script"},
+		},
+		"action": []interface{}{
+			map[string]interface{}{"name": "Custom", "action_type": "Octopus.Custom"},
+		},
+	}
+
+	step := expandDeploymentStep(context.Background(), flattenedStep)
+	require.Equal(t, []string{"Custom", "Script"}, getActionNames(step.Actions))
+}
+
+func TestFlattenDeploymentStepsWithMixedActionTypes(t *testing.T) {
+	step := deployments.NewDeploymentStep("Rolling Step")
+	step.Actions = []*deployments.DeploymentAction{
+		deployments.NewDeploymentAction("Script A", "Octopus.Script"),
+		deployments.NewDeploymentAction("Package", "Octopus.TentaclePackage"),
+		deployments.NewDeploymentAction("Custom", "Octopus.Custom"),
+		deployments.NewDeploymentAction("Script B", "Octopus.Script"),
+	}
+
+	flattenedSteps := flattenDeploymentSteps([]*deployments.DeploymentStep{step})
+	require.Len(t, flattenedSteps, 1)
+
+	runScriptActions := flattenedSteps[0]["run_script_action"].([]map[string]interface{})
+	require.Len(t, runScriptActions, 2)
+	require.Equal(t, "Script A", runScriptActions[0]["name"])
+	require.Equal(t, 1, runScriptActions[0]["sort_order"])
+	require.Equal(t, "Script B", runScriptActions[1]["name"])
+	require.Equal(t, 4, runScriptActions[1]["sort_order"])
+
+	deployPackageActions := flattenedSteps[0]["deploy_package_action"].([]map[string]interface{})
+	require.Len(t, deployPackageActions, 1)
+	require.Equal(t, 2, deployPackageActions[0]["sort_order"])
+
+	actions := flattenedSteps[0]["action"].([]map[string]interface{})
+	require.Len(t, actions, 1)
+	require.Equal(t, 3, actions[0]["sort_order"])
+}
+
+func TestDeploymentStepRoundTripPreservesActionOrder(t *testing.T) {
+	step := deployments.NewDeploymentStep("Rolling Step")
+	step.Actions = []*deployments.DeploymentAction{
+		deployments.NewDeploymentAction("Custom", "Octopus.Custom"),
+		deployments.NewDeploymentAction("Script", "Octopus.Script"),
+		deployments.NewDeploymentAction("Package", "Octopus.TentaclePackage"),
+	}
+
+	flattenedStep := flattenDeploymentSteps([]*deployments.DeploymentStep{step})[0]
+
+	// expansion reads the typed blocks as lists of generic values, as they are presented by the SDK
+	stateStep := map[string]interface{}{"name": flattenedStep["name"]}
+	for _, blockName := range []string{"action", "run_script_action", "deploy_package_action"} {
+		blocks := []interface{}{}
+		for _, block := range flattenedStep[blockName].([]map[string]interface{}) {
+			blocks = append(blocks, block)
+		}
+		stateStep[blockName] = blocks
+	}
+
+	expandedStep := expandDeploymentStep(context.Background(), stateStep)
+	require.Equal(t, []string{"Custom", "Script", "Package"}, getActionNames(expandedStep.Actions))
+}
+
+func TestActionSortOrderZeroIsOmitted(t *testing.T) {
+	_, element := getActionSchema()
+	sortOrderSchema := element.Schema["sort_order"]
+
+	require.False(t, sortOrderSchema.ValidateDiagFunc(0, cty.Path{}).HasError())
+	require.True(t, sortOrderSchema.ValidateDiagFunc(-1, cty.Path{}).HasError())
+	require.True(t, sortOrderSchema.DiffSuppressFunc("step.0.run_script_action.0.sort_order", "2", "0", nil))
+	require.False(t, sortOrderSchema.DiffSuppressFunc("step.0.run_script_action.0.sort_order", "2", "1", nil))
+}
@@ -79,6 +79,32 @@ func flattenDeploymentTarget(deploymentTarget *machines.DeploymentTarget) map[st
 	}
 }
 
+// flattenTypedDeploymentTargets flattens deployment targets into the typed result lists of the deployment targets data
+// source, which are keyed by their attribute names. Every typed list is returned, even when it is empty.
+func flattenTypedDeploymentTargets(deploymentTargets []*machines.DeploymentTarget) map[string][]interface{} {
+	typedLists := getTypedDeploymentTargetLists()
+
+	flattenedDeploymentTargets := map[string][]interface{}{}
+	for _, typedList := range typedLists {
+		flattenedDeploymentTargets[typedList.key] = []interface{}{}
+	}
+
+	for _, deploymentTarget := range deploymentTargets {
+		if deploymentTarget == nil || deploymentTarget.Endpoint == nil {
+			continue
+		}
+
+		communicationStyle := deploymentTarget.Endpoint.GetCommunicationStyle()
+		for _, typedList := range typedLists {
+			if typedList.communicationStyle == communicationStyle {
+				flattenedDeploymentTargets[typedList.key] = append(flattenedDeploymentTargets[typedList.key], typedList.flatten(deploymentTarget))
+			}
+		}
+	}
+
+	return flattenedDeploymentTargets
+}
+
 func getDeploymentTargetDataSchema() map[string]*schema.Schema {
 	dataSchema := getDeploymentTargetSchema()
 	setDataSchema(&dataSchema)
@@ -101,6 +127,7 @@ func getDeploymentTargetDataSchema() map[string]*schema.Schema {
 		"partial_name":    getQueryPartialName(),
 		"roles":           getQueryRoles(),
 		"shell_names":     getQueryShellNames(),
+		"all_pages":       getQueryAllPages(),
 		"skip":            getQuerySkip(),
 		"take":            getQueryTake(),
 		"tenants":         getQueryTenants(),
@@ -110,6 +137,51 @@ func getDeploymentTargetDataSchema() map[string]*schema.Schema {
 	}
 }
 
+// typedDeploymentTargetList describes a result list of the deployment targets data source that holds the deployment
+// targets of a single communication style with the attributes of their endpoints.
+type typedDeploymentTargetList struct {
+	communicationStyle string
+	description        string
+	flatten            func(*machines.DeploymentTarget) map[string]interface{}
+	getSchema          func() map[string]*schema.Schema
+	key                string
+}
+
+func getTypedDeploymentTargetLists() []typedDeploymentTargetList {
+	return []typedDeploymentTargetList{
+		{"AzureCloudService", "Azure cloud service", flattenAzureCloudServiceDeploymentTarget, getAzureCloudServiceDeploymentTargetSchema, "azure_cloud_service_deployment_targets"},
+		{"AzureServiceFabricCluster", "Azure service fabric cluster", flattenAzureServiceFabricClusterDeploymentTarget, getAzureServiceFabricClusterDeploymentTargetSchema, "azure_service_fabric_cluster_deployment_targets"},
+		{"AzureWebApp", "Azure web app", flattenAzureWebAppDeploymentTarget, getAzureWebAppDeploymentTargetSchema, "azure_web_app_deployment_targets"},
+		{"None", "cloud region", flattenCloudRegionDeploymentTarget, getCloudRegionDeploymentTargetSchema, "cloud_region_deployment_targets"},
+		{"Kubernetes", "Kubernetes cluster", flattenKubernetesClusterDeploymentTarget, getKubernetesClusterDeploymentTargetSchema, "kubernetes_cluster_deployment_targets"},
+		{"TentaclePassive", "listening tentacle", flattenListeningTentacleDeploymentTarget, getListeningTentacleDeploymentTargetSchema, "listening_tentacle_deployment_targets"},
+		{"OfflineDrop", "offline package drop", flattenOfflinePackageDropDeploymentTarget, getOfflinePackageDropDeploymentTargetSchema, "offline_package_drop_deployment_targets"},
+		{"TentacleActive", "polling tentacle", flattenPollingTentacleDeploymentTarget, getPollingTentacleDeploymentTargetSchema, "polling_tentacle_deployment_targets"},
+		{"Ssh", "SSH connection", flattenSSHConnectionDeploymentTarget, getSSHConnectionDeploymentTargetSchema, "ssh_connection_deployment_targets"},
+	}
+}
+
+// getDeploymentTargetsDataSchema returns the schema of the deployment targets data source, which returns the deployment
+// targets that match the filter(s) in a single list as well as in a list per communication style.
+func getDeploymentTargetsDataSchema() map[string]*schema.Schema {
+	deploymentTargetsDataSchema := getDeploymentTargetDataSchema()
+	deploymentTargetsDataSchema["deployment_targets"].Description = "A list of deployment targets that match the filter(s), regardless of their communication style."
+
+	for _, typedList := range getTypedDeploymentTargetLists() {
+		dataSchema := typedList.getSchema()
+		setDataSchema(&dataSchema)
+
+		deploymentTargetsDataSchema[typedList.key] = &schema.Schema{
+			Computed:    true,
+			Description: fmt.Sprintf("A list of %s deployment targets that match the filter(s).", typedList.description),
+			Elem:        &schema.Resource{Schema: dataSchema},
+			Type:        schema.TypeList,
+		}
+	}
+
+	return deploymentTargetsDataSchema
+}
+
 func getDeploymentTargetSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"endpoint": {
@@ -0,0 +1,304 @@
+package octopusdeploy
+
+import (
+	"sort"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/teams"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/userroles"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// effectivePermission is a space permission granted to a team by one of its scoped user roles. Empty restrictions
+// mean that the permission applies to all environments, projects, project groups or tenants of the space.
+type effectivePermission struct {
+	EnvironmentIDs   []string
+	Permission       string
+	ProjectGroupIDs  []string
+	ProjectIDs       []string
+	ScopedUserRoleID string
+	SpaceID          string
+	TeamID           string
+	TenantIDs        []string
+	UserRoleID       string
+}
+
+// effectivePermissionsFilter limits the effective permissions that are returned to some spaces or permissions.
+type effectivePermissionsFilter struct {
+	Permissions []string
+	SpaceID     string
+}
+
+func (f effectivePermissionsFilter) includesPermission(permission string) bool {
+	if len(f.Permissions) == 0 {
+		return true
+	}
+
+	for _, includedPermission := range f.Permissions {
+		if includedPermission == permission {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (f effectivePermissionsFilter) includesSpace(spaceID string) bool {
+	return len(f.SpaceID) == 0 || f.SpaceID == spaceID
+}
+
+// resolveTeamPermissions resolves the space and system permissions granted to a team through its scoped user roles.
+func resolveTeamPermissions(teamID string, scopedUserRoles []*userroles.ScopedUserRole, userRoles map[string]*userroles.UserRole, filter effectivePermissionsFilter) ([]effectivePermission, []string) {
+	spacePermissions := []effectivePermission{}
+	systemPermissions := []string{}
+
+	for _, scopedUserRole := range scopedUserRoles {
+		userRole, ok := userRoles[scopedUserRole.UserRoleID]
+		if !ok {
+			continue
+		}
+
+		// system permissions are only granted by user roles that are scoped to the system rather than to a space, and
+		// those grant no space permissions
+		if len(scopedUserRole.SpaceID) == 0 {
+			for _, permission := range userRole.GrantedSystemPermissions {
+				if filter.includesPermission(permission) {
+					systemPermissions = append(systemPermissions, permission)
+				}
+			}
+			continue
+		}
+
+		if !filter.includesSpace(scopedUserRole.SpaceID) {
+			continue
+		}
+
+		for _, permission := range userRole.GrantedSpacePermissions {
+			if !filter.includesPermission(permission) {
+				continue
+			}
+
+			spacePermissions = append(spacePermissions, effectivePermission{
+				EnvironmentIDs:   scopedUserRole.EnvironmentIDs,
+				Permission:       permission,
+				ProjectGroupIDs:  scopedUserRole.ProjectGroupIDs,
+				ProjectIDs:       scopedUserRole.ProjectIDs,
+				ScopedUserRoleID: scopedUserRole.GetID(),
+				SpaceID:          scopedUserRole.SpaceID,
+				TeamID:           teamID,
+				TenantIDs:        scopedUserRole.TenantIDs,
+				UserRoleID:       userRole.GetID(),
+			})
+		}
+	}
+
+	return spacePermissions, systemPermissions
+}
+
+// sortEffectivePermissions orders effective permissions by space, permission, team and scoped user role so that the
+// results of the data sources are stable between reads.
+func sortEffectivePermissions(permissions []effectivePermission) {
+	sort.SliceStable(permissions, func(i, j int) bool {
+		a, b := permissions[i], permissions[j]
+		if a.SpaceID != b.SpaceID {
+			return a.SpaceID < b.SpaceID
+		}
+		if a.Permission != b.Permission {
+			return a.Permission < b.Permission
+		}
+		if a.TeamID != b.TeamID {
+			return a.TeamID < b.TeamID
+		}
+		return a.ScopedUserRoleID < b.ScopedUserRoleID
+	})
+}
+
+func uniqueSortedStrings(values []string) []string {
+	isIncluded := map[string]bool{}
+	uniqueValues := []string{}
+	for _, value := range values {
+		if !isIncluded[value] {
+			isIncluded[value] = true
+			uniqueValues = append(uniqueValues, value)
+		}
+	}
+	sort.Strings(uniqueValues)
+
+	return uniqueValues
+}
+
+// getTeamEffectivePermissions fetches the scoped user roles of a team, along with their user roles, and resolves the
+// permissions they grant. User roles are cached in userRoles, since teams commonly share them.
+func getTeamEffectivePermissions(client *client.Client, teamID string, userRoles map[string]*userroles.UserRole, filter effectivePermissionsFilter) ([]effectivePermission, []string, error) {
+	team, err := client.Teams.GetByID(teamID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scopedUserRoles, err := getTeamScopedUserRoles(client, team)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, scopedUserRole := range scopedUserRoles {
+		if _, ok := userRoles[scopedUserRole.UserRoleID]; ok {
+			continue
+		}
+
+		userRole, err := client.UserRoles.GetByID(scopedUserRole.UserRoleID)
+		if err != nil {
+			return nil, nil, err
+		}
+		userRoles[scopedUserRole.UserRoleID] = userRole
+	}
+
+	spacePermissions, systemPermissions := resolveTeamPermissions(teamID, scopedUserRoles, userRoles, filter)
+	return spacePermissions, systemPermissions, nil
+}
+
+func getTeamScopedUserRoles(client *client.Client, team *teams.Team) ([]*userroles.ScopedUserRole, error) {
+	scopedUserRoles, err := client.Teams.GetScopedUserRoles(*team, core.SkipTakeQuery{})
+	if err != nil {
+		return nil, err
+	}
+
+	return scopedUserRoles.GetAllPages(client.Sling())
+}
+
+func flattenEffectivePermissions(permissions []effectivePermission) []interface{} {
+	flattenedPermissions := []interface{}{}
+	for _, permission := range permissions {
+		flattenedPermissions = append(flattenedPermissions, map[string]interface{}{
+			"environment_ids":     permission.EnvironmentIDs,
+			"permission":          permission.Permission,
+			"project_group_ids":   permission.ProjectGroupIDs,
+			"project_ids":         permission.ProjectIDs,
+			"scoped_user_role_id": permission.ScopedUserRoleID,
+			"space_id":            permission.SpaceID,
+			"team_id":             permission.TeamID,
+			"tenant_ids":          permission.TenantIDs,
+			"user_role_id":        permission.UserRoleID,
+		})
+	}
+
+	return flattenedPermissions
+}
+
+func expandEffectivePermissionsFilter(d *schema.ResourceData) effectivePermissionsFilter {
+	return effectivePermissionsFilter{
+		Permissions: getSliceFromTerraformTypeList(d.Get("permissions")),
+		SpaceID:     d.Get("space_id").(string),
+	}
+}
+
+func getEffectivePermissionsDataSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": getDataSchemaID(),
+		"permissions": {
+			Description: "A list of permissions (i.e. `DeploymentCreate`) to limit the results to. All permissions are returned if this is not set.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Type:        schema.TypeSet,
+		},
+		"space_id": {
+			Description: "The ID of the space to limit the space permissions to. Space permissions of all spaces are returned if this is not set.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"space_permissions": {
+			Computed:    true,
+			Description: "A list of the space permissions that are granted, one for each scoped user role that grants the permission. Empty restrictions mean that the permission applies to everything in the space.",
+			Elem:        &schema.Resource{Schema: getEffectivePermissionSchema()},
+			Type:        schema.TypeList,
+		},
+		"system_permissions": {
+			Computed:    true,
+			Description: "A list of the system permissions that are granted.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Type:        schema.TypeList,
+		},
+	}
+}
+
+func getEffectivePermissionSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"environment_ids": {
+			Computed:    true,
+			Description: "The IDs of the environments that the permission is restricted to.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Type:        schema.TypeList,
+		},
+		"permission": {
+			Computed:    true,
+			Description: "The name of the permission (i.e. `DeploymentCreate`).",
+			Type:        schema.TypeString,
+		},
+		"project_group_ids": {
+			Computed:    true,
+			Description: "The IDs of the project groups that the permission is restricted to.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Type:        schema.TypeList,
+		},
+		"project_ids": {
+			Computed:    true,
+			Description: "The IDs of the projects that the permission is restricted to.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Type:        schema.TypeList,
+		},
+		"scoped_user_role_id": {
+			Computed:    true,
+			Description: "The ID of the scoped user role that grants the permission.",
+			Type:        schema.TypeString,
+		},
+		"space_id": {
+			Computed:    true,
+			Description: "The ID of the space that the permission applies to.",
+			Type:        schema.TypeString,
+		},
+		"team_id": {
+			Computed:    true,
+			Description: "The ID of the team that the permission is granted through.",
+			Type:        schema.TypeString,
+		},
+		"tenant_ids": {
+			Computed:    true,
+			Description: "The IDs of the tenants that the permission is restricted to.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Type:        schema.TypeList,
+		},
+		"user_role_id": {
+			Computed:    true,
+			Description: "The ID of the user role that grants the permission.",
+			Type:        schema.TypeString,
+		},
+	}
+}
+
+func getTeamPermissionsDataSchema() map[string]*schema.Schema {
+	dataSchema := getEffectivePermissionsDataSchema()
+	dataSchema["team_id"] = &schema.Schema{
+		Description: "The ID of the team.",
+		Required:    true,
+		Type:        schema.TypeString,
+	}
+
+	return dataSchema
+}
+
+func getUserPermissionsDataSchema() map[string]*schema.Schema {
+	dataSchema := getEffectivePermissionsDataSchema()
+	dataSchema["team_ids"] = &schema.Schema{
+		Computed:    true,
+		Description: "The IDs of the teams that the user is a member of, either directly or through external security groups.",
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Type:        schema.TypeList,
+	}
+	dataSchema["user_id"] = &schema.Schema{
+		Description: "The ID of the user.",
+		Required:    true,
+		Type:        schema.TypeString,
+	}
+
+	return dataSchema
+}
@@ -0,0 +1,92 @@
+package octopusdeploy
+
+import (
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/userroles"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestScopedUserRole(id string, userRoleID string, spaceID string) *userroles.ScopedUserRole {
+	scopedUserRole := userroles.NewScopedUserRole(userRoleID)
+	scopedUserRole.ID = id
+	scopedUserRole.SpaceID = spaceID
+	scopedUserRole.TeamID = "Teams-1"
+	return scopedUserRole
+}
+
+func newTestUserRole(id string, spacePermissions []string, systemPermissions []string) *userroles.UserRole {
+	userRole := userroles.NewUserRole(id)
+	userRole.ID = id
+	userRole.GrantedSpacePermissions = spacePermissions
+	userRole.GrantedSystemPermissions = systemPermissions
+	return userRole
+}
+
+func TestResolveTeamPermissions(t *testing.T) {
+	deployer := newTestScopedUserRole("ScopedUserRoles-1", "userroles-deployer", "Spaces-1")
+	deployer.EnvironmentIDs = []string{"Environments-1"}
+	deployer.ProjectIDs = []string{"Projects-1"}
+
+	viewer := newTestScopedUserRole("ScopedUserRoles-2", "userroles-viewer", "Spaces-2")
+	unknown := newTestScopedUserRole("ScopedUserRoles-3", "userroles-unknown", "Spaces-1")
+	administrator := newTestScopedUserRole("ScopedUserRoles-4", "userroles-administrator", "")
+
+	userRoles := map[string]*userroles.UserRole{
+		"userroles-administrator": newTestUserRole("userroles-administrator", []string{"ProjectView"}, []string{"SpaceView", "TeamEdit"}),
+		"userroles-deployer":      newTestUserRole("userroles-deployer", []string{"DeploymentCreate", "ProjectView"}, nil),
+		"userroles-viewer":        newTestUserRole("userroles-viewer", []string{"ProjectView"}, []string{"UserView"}),
+	}
+	scopedUserRoles := []*userroles.ScopedUserRole{deployer, viewer, unknown, administrator}
+
+	spacePermissions, systemPermissions := resolveTeamPermissions("Teams-1", scopedUserRoles, userRoles, effectivePermissionsFilter{})
+	require.Len(t, spacePermissions, 3)
+	require.Equal(t, []string{"SpaceView", "TeamEdit"}, systemPermissions)
+	require.Equal(t, "DeploymentCreate", spacePermissions[0].Permission)
+	require.Equal(t, []string{"Environments-1"}, spacePermissions[0].EnvironmentIDs)
+	require.Equal(t, []string{"Projects-1"}, spacePermissions[0].ProjectIDs)
+	require.Equal(t, "ScopedUserRoles-1", spacePermissions[0].ScopedUserRoleID)
+	require.Equal(t, "Teams-1", spacePermissions[0].TeamID)
+	require.Equal(t, "userroles-deployer", spacePermissions[0].UserRoleID)
+
+	spacePermissions, systemPermissions = resolveTeamPermissions("Teams-1", scopedUserRoles, userRoles, effectivePermissionsFilter{SpaceID: "Spaces-1"})
+	require.Len(t, spacePermissions, 2)
+	require.Equal(t, []string{"SpaceView", "TeamEdit"}, systemPermissions)
+
+	spacePermissions, systemPermissions = resolveTeamPermissions("Teams-1", scopedUserRoles, userRoles, effectivePermissionsFilter{Permissions: []string{"ProjectView"}})
+	require.Len(t, spacePermissions, 2)
+	require.Empty(t, systemPermissions)
+}
+
+func TestSortEffectivePermissions(t *testing.T) {
+	permissions := []effectivePermission{
+		{Permission: "ProjectView", SpaceID: "Spaces-1", TeamID: "Teams-2"},
+		{Permission: "ProjectView", SpaceID: "Spaces-1", TeamID: "Teams-1"},
+		{Permission: "DeploymentCreate", SpaceID: "Spaces-2", TeamID: "Teams-1"},
+		{Permission: "DeploymentCreate", SpaceID: "Spaces-1", TeamID: "Teams-1"},
+	}
+
+	sortEffectivePermissions(permissions)
+
+	require.Equal(t, effectivePermission{Permission: "DeploymentCreate", SpaceID: "Spaces-1", TeamID: "Teams-1"}, permissions[0])
+	require.Equal(t, effectivePermission{Permission: "ProjectView", SpaceID: "Spaces-1", TeamID: "Teams-1"}, permissions[1])
+	require.Equal(t, effectivePermission{Permission: "ProjectView", SpaceID: "Spaces-1", TeamID: "Teams-2"}, permissions[2])
+	require.Equal(t, effectivePermission{Permission: "DeploymentCreate", SpaceID: "Spaces-2", TeamID: "Teams-1"}, permissions[3])
+}
+
+func TestUniqueSortedStrings(t *testing.T) {
+	require.Equal(t, []string{"a", "b", "c"}, uniqueSortedStrings([]string{"c", "a", "b", "a"}))
+	require.Equal(t, []string{}, uniqueSortedStrings(nil))
+}
+
+func TestResolveTeamPermissionsIgnoresSystemPermissionsOfSpaceScopedRoles(t *testing.T) {
+	scopedUserRoles := []*userroles.ScopedUserRole{newTestScopedUserRole("ScopedUserRoles-1", "userroles-manager", "Spaces-1")}
+	userRoles := map[string]*userroles.UserRole{
+		"userroles-manager": newTestUserRole("userroles-manager", []string{"ProjectEdit"}, []string{"SpaceCreate", "UserView"}),
+	}
+
+	spacePermissions, systemPermissions := resolveTeamPermissions("Teams-1", scopedUserRoles, userRoles, effectivePermissionsFilter{})
+	require.Len(t, spacePermissions, 1)
+	require.Equal(t, "ProjectEdit", spacePermissions[0].Permission)
+	require.Empty(t, systemPermissions)
+}
@@ -110,6 +110,7 @@ func getEnvironmentDataSchema() map[string]*schema.Schema {
 		"ids":          getQueryIDs(),
 		"name":         getQueryName(),
 		"partial_name": getQueryPartialName(),
+		"all_pages":    getQueryAllPages(),
 		"skip":         getQuerySkip(),
 		"take":         getQueryTake(),
 		"space_id":     getSpaceIDSchema(),
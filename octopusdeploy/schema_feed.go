@@ -46,6 +46,7 @@ func getFeedDataSchema() map[string]*schema.Schema {
 		"ids":          getQueryIDs(),
 		"name":         getQueryName(),
 		"partial_name": getQueryPartialName(),
+		"all_pages":    getQueryAllPages(),
 		"skip":         getQuerySkip(),
 		"take":         getQueryTake(),
 		"space_id":     getSpaceIDSchema(),
@@ -12,7 +12,7 @@ import (
 
 func expandGoogleCloudPlatformAccount(d *schema.ResourceData) *accounts.GoogleCloudPlatformAccount {
 	name := d.Get("name").(string)
-	jsonKey := core.NewSensitiveValue(d.Get("json_key").(string))
+	jsonKey := expandAccountSecret(d, "json_key")
 
 	account, _ := accounts.NewGoogleCloudPlatformAccount(name, jsonKey)
 	account.ID = d.Id()
@@ -45,7 +45,7 @@ func expandGoogleCloudPlatformAccount(d *schema.ResourceData) *accounts.GoogleCl
 }
 
 func getGoogleCloudPlatformAccountSchema() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
+	return addAccountCredentialSchema(map[string]*schema.Schema{
 		"description": {
 			Description: "A user-friendly description of this GCP account.",
 			Optional:    true,
@@ -68,7 +68,7 @@ func getGoogleCloudPlatformAccountSchema() map[string]*schema.Schema {
 		"tenanted_deployment_participation": getTenantedDeploymentSchema(),
 		"tenants":                           getTenantsSchema(),
 		"tenant_tags":                       getTenantTagsSchema(),
-	}
+	}, "json_key")
 }
 
 func setGoogleCloudPlatformAccount(ctx context.Context, d *schema.ResourceData, account *accounts.GoogleCloudPlatformAccount) error {
@@ -89,5 +89,9 @@ func setGoogleCloudPlatformAccount(ctx context.Context, d *schema.ResourceData,
 		return fmt.Errorf("error setting tenant_tags: %s", err)
 	}
 
+	if err := setAccountModification(d, account); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -0,0 +1,37 @@
+package octopusdeploy
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/oidcaccounts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func expandGoogleCloudPlatformOpenIDConnectAccount(d *schema.ResourceData) *oidcaccounts.GoogleCloudOIDCAccount {
+	name := d.Get("name").(string)
+	audience := d.Get("audience").(string)
+
+	account := oidcaccounts.NewGoogleCloudOIDCAccount(name, audience)
+
+	expandOpenIDConnectAccount(d, &account.Account)
+
+	return account
+}
+
+func getGoogleCloudPlatformOpenIDConnectAccountSchema() map[string]*schema.Schema {
+	return getOpenIDConnectAccountSchema("GCP OpenID Connect account", map[string]*schema.Schema{
+		"audience": {
+			Description:      "The audience of the OIDC tokens that are exchanged through workload identity federation (i.e. `//iam.googleapis.com/projects/<number>/locations/global/workloadIdentityPools/<pool>/providers/<provider>`).",
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+	})
+}
+
+func setGoogleCloudPlatformOpenIDConnectAccount(ctx context.Context, d *schema.ResourceData, account *oidcaccounts.GoogleCloudOIDCAccount) error {
+	d.Set("audience", account.Audience)
+
+	return setOpenIDConnectAccount(d, &account.Account)
+}
@@ -55,10 +55,11 @@ func getGitCredentialDataSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Type:        schema.TypeList,
 		},
-		"name":     getQueryName(),
-		"skip":     getQuerySkip(),
-		"take":     getQueryTake(),
-		"space_id": getSpaceIDSchema(),
+		"name":      getQueryName(),
+		"all_pages": getQueryAllPages(),
+		"skip":      getQuerySkip(),
+		"take":      getQueryTake(),
+		"space_id":  getSpaceIDSchema(),
 	}
 }
 
@@ -0,0 +1,136 @@
+package octopusdeploy
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/services/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// gitBranch is the head of a branch in the repository of a version-controlled project.
+type gitBranch struct {
+	CanonicalName string `json:"CanonicalName,omitempty"`
+	CommitID      string `json:"CommitId,omitempty"`
+	IsProtected   bool   `json:"IsProtected"`
+	Name          string `json:"Name,omitempty"`
+}
+
+func addGitRefSchema(element map[string]*schema.Schema, isComputed bool) {
+	element["allow_protected_branch_commit"] = &schema.Schema{
+		Default:     false,
+		Description: "Allows changes to be committed to a protected branch of a version-controlled project. Commits to protected branches are refused unless this is set.",
+		Optional:    true,
+		Type:        schema.TypeBool,
+	}
+	element["git_commit"] = &schema.Schema{
+		Computed:    true,
+		Description: "The SHA of the commit produced by the most recent change to a version-controlled project.",
+		Type:        schema.TypeString,
+	}
+	element["git_commit_message"] = &schema.Schema{
+		Description: "The commit message used when changes are committed to a version-controlled project.",
+		Optional:    true,
+		Type:        schema.TypeString,
+	}
+	element["git_ref"] = &schema.Schema{
+		Computed:    isComputed,
+		Description: "The git reference (i.e. `main` or `refs/heads/main`) to read and commit to. This value only applies to projects that are stored in version control.",
+		ForceNew:    true,
+		Optional:    true,
+		Type:        schema.TypeString,
+	}
+}
+
+// getGitPersistenceSettings returns the version control settings of a project, or nil if the project is stored in the database.
+func getGitPersistenceSettings(project *projects.Project) projects.GitPersistenceSettings {
+	if project == nil || project.PersistenceSettings == nil || project.PersistenceSettings.Type() != projects.PersistenceSettingsTypeVersionControlled {
+		return nil
+	}
+
+	return project.PersistenceSettings.(projects.GitPersistenceSettings)
+}
+
+func getBranchName(gitRef string) string {
+	return strings.TrimPrefix(gitRef, "refs/heads/")
+}
+
+func isProtectedBranch(protectedBranchNamePatterns []string, gitRef string) bool {
+	branchName := getBranchName(gitRef)
+	for _, pattern := range protectedBranchNamePatterns {
+		if matched, err := path.Match(pattern, branchName); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+// validateGitRefCommit refuses commits to a protected branch unless the resource explicitly allows them.
+func validateGitRefCommit(d *schema.ResourceData, gitPersistenceSettings projects.GitPersistenceSettings, gitRef string) error {
+	if gitPersistenceSettings == nil || d.Get("allow_protected_branch_commit").(bool) {
+		return nil
+	}
+
+	if isProtectedBranch(gitPersistenceSettings.ProtectedBranchNamePatterns(), gitRef) {
+		return fmt.Errorf("the git reference '%s' is a protected branch; set allow_protected_branch_commit to commit to it", gitRef)
+	}
+
+	return nil
+}
+
+func getGitCommitMessage(d *schema.ResourceData, resourceName string) string {
+	if v, ok := d.GetOk("git_commit_message"); ok {
+		return v.(string)
+	}
+
+	return fmt.Sprintf("Update %s via Terraform", resourceName)
+}
+
+// addChangeDescription returns the JSON representation of a resource with the commit message that the server
+// uses when it commits a change to a version-controlled project.
+func addChangeDescription(resource interface{}, commitMessage string) (map[string]interface{}, error) {
+	serialized, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	body := map[string]interface{}{}
+	if err := json.Unmarshal(serialized, &body); err != nil {
+		return nil, err
+	}
+
+	body["ChangeDescription"] = commitMessage
+	return body, nil
+}
+
+// commitVersionControlledResource writes a resource of a version-controlled project, committing it with the given message.
+func commitVersionControlledResource(client *client.Client, path string, resource interface{}, commitMessage string, output interface{}) error {
+	body, err := addChangeDescription(resource, commitMessage)
+	if err != nil {
+		return err
+	}
+
+	_, err = services.ApiUpdate(client.Sling(), body, output, path)
+	return err
+}
+
+// getGitCommit returns the SHA of the commit at the head of a branch of a version-controlled project.
+func getGitCommit(client *client.Client, spaceID string, projectID string, gitRef string) (string, error) {
+	if len(spaceID) == 0 {
+		spaceID = client.GetSpaceID()
+	}
+
+	path := fmt.Sprintf("/api/%s/projects/%s/git/branches/%s", spaceID, projectID, url.PathEscape(getBranchName(gitRef)))
+	resp, err := api.ApiGet(client.Sling(), new(gitBranch), path)
+	if err != nil {
+		return "", err
+	}
+
+	return resp.(*gitBranch).CommitID, nil
+}
@@ -0,0 +1,58 @@
+package octopusdeploy
+
+import (
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/deployments"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsProtectedBranch(t *testing.T) {
+	patterns := []string{"main", "release/*"}
+
+	require.True(t, isProtectedBranch(patterns, "main"))
+	require.True(t, isProtectedBranch(patterns, "refs/heads/main"))
+	require.True(t, isProtectedBranch(patterns, "release/1.0"))
+	require.False(t, isProtectedBranch(patterns, "feature/main"))
+	require.False(t, isProtectedBranch(patterns, "develop"))
+	require.False(t, isProtectedBranch(nil, "main"))
+}
+
+func TestValidateGitRefCommit(t *testing.T) {
+	gitRefSchema := map[string]*schema.Schema{}
+	addGitRefSchema(gitRefSchema, false)
+
+	settings := projects.NewGitPersistenceSettings(".octopus", nil, "main", []string{"main"}, nil)
+
+	d := schema.TestResourceDataRaw(t, gitRefSchema, map[string]interface{}{"git_ref": "main"})
+	require.Error(t, validateGitRefCommit(d, settings, "main"))
+	require.NoError(t, validateGitRefCommit(d, settings, "feature"))
+	require.NoError(t, validateGitRefCommit(d, nil, "main"))
+
+	d = schema.TestResourceDataRaw(t, gitRefSchema, map[string]interface{}{"git_ref": "main", "allow_protected_branch_commit": true})
+	require.NoError(t, validateGitRefCommit(d, settings, "main"))
+}
+
+func TestGetGitCommitMessage(t *testing.T) {
+	gitRefSchema := map[string]*schema.Schema{}
+	addGitRefSchema(gitRefSchema, false)
+
+	d := schema.TestResourceDataRaw(t, gitRefSchema, map[string]interface{}{})
+	require.Equal(t, "Update variable via Terraform", getGitCommitMessage(d, "variable"))
+
+	d = schema.TestResourceDataRaw(t, gitRefSchema, map[string]interface{}{"git_commit_message": "Add smoke tests"})
+	require.Equal(t, "Add smoke tests", getGitCommitMessage(d, "variable"))
+}
+
+func TestAddChangeDescription(t *testing.T) {
+	deploymentProcess := deployments.NewDeploymentProcess("Projects-1")
+	deploymentProcess.Version = 3
+
+	body, err := addChangeDescription(deploymentProcess, "Add smoke tests")
+	require.NoError(t, err)
+	require.Equal(t, "Add smoke tests", body["ChangeDescription"])
+	require.Equal(t, "Projects-1", body["ProjectId"])
+	require.EqualValues(t, 3, body["Version"])
+}
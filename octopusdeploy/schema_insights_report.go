@@ -0,0 +1,241 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/insights"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func expandInsightsReport(d *schema.ResourceData) *insights.InsightsReport {
+	name := d.Get("name").(string)
+
+	report := insights.NewInsightsReport(name)
+	report.ID = d.Id()
+
+	if v, ok := d.GetOk("cadence"); ok {
+		report.Cadence = v.(string)
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		report.Description = v.(string)
+	}
+
+	if v, ok := d.GetOk("environment_ids"); ok {
+		report.EnvironmentIDs = getSliceFromTerraformTypeList(v)
+	}
+
+	if v, ok := d.GetOk("project_group_ids"); ok {
+		report.ProjectGroupIDs = getSliceFromTerraformTypeList(v)
+	}
+
+	if v, ok := d.GetOk("project_ids"); ok {
+		report.ProjectIDs = getSliceFromTerraformTypeList(v)
+	}
+
+	if v, ok := d.GetOk("space_id"); ok {
+		report.SpaceID = v.(string)
+	}
+
+	if v, ok := d.GetOk("tenant_ids"); ok {
+		report.TenantIDs = getSliceFromTerraformTypeList(v)
+	}
+
+	if v, ok := d.GetOk("tenant_tags"); ok {
+		report.TenantTags = getSliceFromTerraformTypeList(v)
+	}
+
+	if v, ok := d.GetOk("time_zone"); ok {
+		report.TimeZone = v.(string)
+	}
+
+	return report
+}
+
+func flattenInsightsMetricDataPoints(dataPoints []*insights.MetricDataPoint) []interface{} {
+	flattenedDataPoints := []interface{}{}
+	for _, dataPoint := range dataPoints {
+		if dataPoint == nil {
+			continue
+		}
+
+		flattenedDataPoint := map[string]interface{}{
+			"value": dataPoint.Value,
+		}
+
+		if dataPoint.EndTime != nil {
+			flattenedDataPoint["end_time"] = dataPoint.EndTime.Format(time.RFC3339)
+		}
+
+		if dataPoint.StartTime != nil {
+			flattenedDataPoint["start_time"] = dataPoint.StartTime.Format(time.RFC3339)
+		}
+
+		flattenedDataPoints = append(flattenedDataPoints, flattenedDataPoint)
+	}
+
+	return flattenedDataPoints
+}
+
+func getInsightsReportSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"cadence": {
+			Default:          insights.CadenceLastQuarter,
+			Description:      fmt.Sprintf("The period over which the metrics of this insights report are calculated. Valid cadences are `%s`.", strings.Join(insights.Cadences, "`, `")),
+			Optional:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(insights.Cadences, false)),
+		},
+		"description": getDescriptionSchema("insights report"),
+		"environment_ids": {
+			Description: "A list of environment IDs whose deployments are measured by this insights report.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Type:        schema.TypeList,
+		},
+		"id":   getIDSchema(),
+		"name": getNameSchema(true),
+		"project_group_ids": {
+			Description: "A list of project group IDs whose projects are measured by this insights report.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Type:        schema.TypeList,
+		},
+		"project_ids": {
+			Description: "A list of project IDs measured by this insights report.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Type:        schema.TypeList,
+		},
+		"space_id": getSpaceIDSchema(),
+		"tenant_ids": {
+			Description: "A list of tenant IDs whose deployments are measured by this insights report.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Type:        schema.TypeList,
+		},
+		"tenant_tags": {
+			Description: "A list of tenant tags (i.e. `Regions/us-east`) that select the tenants whose deployments are measured by this insights report.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Type:        schema.TypeList,
+		},
+		"time_zone": {
+			Computed:    true,
+			Description: "The time zone (i.e. `Australia/Brisbane`) used to align the intervals of this insights report.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+	}
+}
+
+func getInsightsMetricDataPointSchema() *schema.Schema {
+	return &schema.Schema{
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"end_time": {
+					Computed:    true,
+					Description: "The end of the interval, in RFC 3339 format.",
+					Type:        schema.TypeString,
+				},
+				"start_time": {
+					Computed:    true,
+					Description: "The start of the interval, in RFC 3339 format.",
+					Type:        schema.TypeString,
+				},
+				"value": {
+					Computed:    true,
+					Description: "The value of the metric over the interval.",
+					Type:        schema.TypeFloat,
+				},
+			},
+		},
+		Type: schema.TypeList,
+	}
+}
+
+func getInsightsMetricsDataSchema() map[string]*schema.Schema {
+	changeFailureRate := getInsightsMetricDataPointSchema()
+	changeFailureRate.Description = "The percentage of deployments that failed or required a fix, per interval."
+
+	deploymentFrequency := getInsightsMetricDataPointSchema()
+	deploymentFrequency.Description = "The number of successful deployments, per interval."
+
+	leadTime := getInsightsMetricDataPointSchema()
+	leadTime.Description = "The median time in hours between a release being created and it being successfully deployed, per interval."
+
+	meanTimeToRecovery := getInsightsMetricDataPointSchema()
+	meanTimeToRecovery.Description = "The median time in hours taken to recover from a failed deployment, per interval."
+
+	return map[string]*schema.Schema{
+		"change_failure_rate":  changeFailureRate,
+		"deployment_frequency": deploymentFrequency,
+		"end_time": {
+			Description:      "The end of the time window, in RFC 3339 format. Defaults to the current time.",
+			Optional:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+		},
+		"granularity": {
+			Description:      fmt.Sprintf("The width of each interval of the metric series. Valid granularities are `%s`.", strings.Join(insights.Granularities, "`, `")),
+			Optional:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(insights.Granularities, false)),
+		},
+		"id":                    getDataSchemaID(),
+		"lead_time":             leadTime,
+		"mean_time_to_recovery": meanTimeToRecovery,
+		"report_id": {
+			Description: "The ID of the insights report whose metrics are returned.",
+			Required:    true,
+			Type:        schema.TypeString,
+		},
+		"space_id": getQuerySpaceID(),
+		"start_time": {
+			Description:      "The start of the time window, in RFC 3339 format. Defaults to the start of the cadence of the insights report.",
+			Optional:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+		},
+	}
+}
+
+func setInsightsReport(ctx context.Context, d *schema.ResourceData, report *insights.InsightsReport) error {
+	d.Set("cadence", report.Cadence)
+	d.Set("description", report.Description)
+
+	if err := d.Set("environment_ids", report.EnvironmentIDs); err != nil {
+		return fmt.Errorf("error setting environment_ids: %s", err)
+	}
+
+	d.Set("name", report.Name)
+
+	if err := d.Set("project_group_ids", report.ProjectGroupIDs); err != nil {
+		return fmt.Errorf("error setting project_group_ids: %s", err)
+	}
+
+	if err := d.Set("project_ids", report.ProjectIDs); err != nil {
+		return fmt.Errorf("error setting project_ids: %s", err)
+	}
+
+	d.Set("space_id", report.SpaceID)
+
+	if err := d.Set("tenant_ids", report.TenantIDs); err != nil {
+		return fmt.Errorf("error setting tenant_ids: %s", err)
+	}
+
+	if err := d.Set("tenant_tags", report.TenantTags); err != nil {
+		return fmt.Errorf("error setting tenant_tags: %s", err)
+	}
+
+	d.Set("time_zone", report.TimeZone)
+
+	d.SetId(report.GetID())
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package octopusdeploy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/insights"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandInsightsReport(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, getInsightsReportSchema(), map[string]interface{}{
+		"environment_ids":   []interface{}{"Environments-1"},
+		"name":              "Web Platform",
+		"project_group_ids": []interface{}{"ProjectGroups-1"},
+		"project_ids":       []interface{}{"Projects-1", "Projects-2"},
+		"tenant_tags":       []interface{}{"Regions/us-east"},
+	})
+
+	report := expandInsightsReport(d)
+	require.Equal(t, "Web Platform", report.Name)
+	require.Equal(t, insights.CadenceLastQuarter, report.Cadence)
+	require.Equal(t, []string{"Environments-1"}, report.EnvironmentIDs)
+	require.Equal(t, []string{"ProjectGroups-1"}, report.ProjectGroupIDs)
+	require.Equal(t, []string{"Projects-1", "Projects-2"}, report.ProjectIDs)
+	require.Empty(t, report.TenantIDs)
+	require.NotNil(t, report.TenantIDs)
+	require.Equal(t, []string{"Regions/us-east"}, report.TenantTags)
+}
+
+func TestSetInsightsReport(t *testing.T) {
+	report := insights.NewInsightsReport("Web Platform")
+	report.ID = "InsightsReports-1"
+	report.Cadence = insights.CadenceLastYear
+	report.ProjectIDs = []string{"Projects-1"}
+	report.TenantIDs = []string{"Tenants-1"}
+
+	d := schema.TestResourceDataRaw(t, getInsightsReportSchema(), map[string]interface{}{})
+	require.NoError(t, setInsightsReport(context.Background(), d, report))
+	require.Equal(t, "InsightsReports-1", d.Id())
+	require.Equal(t, insights.CadenceLastYear, d.Get("cadence"))
+	require.Equal(t, []interface{}{"Projects-1"}, d.Get("project_ids"))
+	require.Equal(t, []interface{}{"Tenants-1"}, d.Get("tenant_ids"))
+}
+
+func TestFlattenInsightsMetricDataPoints(t *testing.T) {
+	startTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	endTime := startTime.AddDate(0, 0, 7)
+
+	flattenedDataPoints := flattenInsightsMetricDataPoints([]*insights.MetricDataPoint{
+		{EndTime: &endTime, StartTime: &startTime, Value: 4.5},
+		nil,
+		{Value: 2},
+	})
+
+	require.Len(t, flattenedDataPoints, 2)
+	require.Equal(t, map[string]interface{}{
+		"end_time":   "2023-01-08T00:00:00Z",
+		"start_time": "2023-01-01T00:00:00Z",
+		"value":      4.5,
+	}, flattenedDataPoints[0])
+	require.Equal(t, map[string]interface{}{"value": float64(2)}, flattenedDataPoints[1])
+}
@@ -96,6 +96,10 @@ func flattenKubernetesClusterDeploymentTarget(deploymentTarget *machines.Deploym
 		flattenedDeploymentTarget["cluster_url"] = endpointResource.ClusterURL.String()
 	}
 
+	if endpointResource.Authentication == nil {
+		return flattenedDeploymentTarget
+	}
+
 	switch endpointResource.Authentication.GetAuthenticationType() {
 	case "KubernetesAws":
 		flattenedDeploymentTarget["aws_account_authentication"] = flattenKubernetesAwsAuthentication(endpointResource.Authentication.(*machines.KubernetesAwsAuthentication))
@@ -233,8 +237,9 @@ func getKubernetesClusterDeploymentTargetSchema() map[string]*schema.Schema {
 	}
 
 	kubernetesClusterDeploymentTargetSchema["proxy_id"] = &schema.Schema{
-		Optional: true,
-		Type:     schema.TypeString,
+		Description: "The ID of the machine proxy (i.e. an `octopusdeploy_machine_proxy`) that Octopus Deploy connects to this deployment target through. The ID is validated against the machine proxies of the space when the plan is made.",
+		Optional:    true,
+		Type:        schema.TypeString,
 	}
 
 	kubernetesClusterDeploymentTargetSchema["running_in_container"] = &schema.Schema{
@@ -66,6 +66,7 @@ func getLibraryVariableSetDataSchema() map[string]*schema.Schema {
 			Type:        schema.TypeList,
 		},
 		"partial_name": getQueryPartialName(),
+		"all_pages":    getQueryAllPages(),
 		"skip":         getQuerySkip(),
 		"take":         getQueryTake(),
 	}
@@ -72,6 +72,7 @@ func getLifecycleDataSchema() map[string]*schema.Schema {
 			Type:        schema.TypeList,
 		},
 		"partial_name": getQueryPartialName(),
+		"all_pages":    getQueryAllPages(),
 		"skip":         getQuerySkip(),
 		"take":         getQueryTake(),
 		"space_id":     getSpaceIDSchema(),
@@ -39,6 +39,8 @@ func flattenListeningTentacleDeploymentTarget(deploymentTarget *machines.Deploym
 	}
 
 	flattenedDeploymentTarget := flattenDeploymentTarget(deploymentTarget)
+	delete(flattenedDeploymentTarget, "endpoint")
+
 	endpointResource, _ := machines.ToEndpointResource(deploymentTarget.Endpoint)
 	flattenedDeploymentTarget["certificate_signature_algorithm"] = endpointResource.CertificateSignatureAlgorithm
 	flattenedDeploymentTarget["proxy_id"] = endpointResource.ProxyID
@@ -115,7 +117,7 @@ func getListeningTentacleDeploymentTargetSchema() map[string]*schema.Schema {
 		},
 		"proxy_id": {
 			Computed:    true,
-			Description: "The proxy ID that is associated with this deployment target.",
+			Description: "The ID of the machine proxy (i.e. an `octopusdeploy_machine_proxy`) that Octopus Deploy connects to this deployment target through. The ID is validated against the machine proxies of the space when the plan is made.",
 			Optional:    true,
 			Type:        schema.TypeString,
 		},
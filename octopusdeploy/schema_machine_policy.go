@@ -121,6 +121,7 @@ func getMachinePolicyDataSchema() map[string]*schema.Schema {
 			Type:        schema.TypeList,
 		},
 		"partial_name": getQueryPartialName(),
+		"all_pages":    getQueryAllPages(),
 		"skip":         getQuerySkip(),
 		"take":         getQueryTake(),
 		"space_id":     getSpaceIDSchema(),
@@ -0,0 +1,115 @@
+package octopusdeploy
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/proxies"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func expandMachineProxy(d *schema.ResourceData) *proxies.Proxy {
+	proxy := proxies.NewProxy(d.Get("name").(string), d.Get("host").(string), d.Get("username").(string), expandMachineProxyPassword(d))
+	proxy.ID = d.Id()
+	proxy.Port = d.Get("port").(int)
+
+	if v, ok := d.GetOk("space_id"); ok {
+		proxy.SpaceID = v.(string)
+	}
+
+	return proxy
+}
+
+// expandMachineProxyPassword only sends the password when it is new or has changed; otherwise the server keeps the
+// password that it already has.
+func expandMachineProxyPassword(d *schema.ResourceData) *core.SensitiveValue {
+	password := d.Get("password").(string)
+	if len(d.Id()) == 0 || d.HasChange("password") || len(password) == 0 {
+		return core.NewSensitiveValue(password)
+	}
+
+	return &core.SensitiveValue{HasValue: true}
+}
+
+func flattenMachineProxy(proxy *proxies.Proxy) map[string]interface{} {
+	if proxy == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"host":     proxy.Host,
+		"id":       proxy.GetID(),
+		"name":     proxy.Name,
+		"port":     proxy.Port,
+		"space_id": proxy.SpaceID,
+		"username": proxy.Username,
+	}
+}
+
+func getMachineProxyDataSchema() map[string]*schema.Schema {
+	dataSchema := getMachineProxySchema()
+	setDataSchema(&dataSchema)
+	delete(dataSchema, "password")
+
+	return map[string]*schema.Schema{
+		"id":  getDataSchemaID(),
+		"ids": getQueryIDs(),
+		"machine_proxies": {
+			Computed:    true,
+			Description: "A list of machine proxies that match the filter(s).",
+			Elem:        &schema.Resource{Schema: dataSchema},
+			Optional:    true,
+			Type:        schema.TypeList,
+		},
+		"partial_name": getQueryPartialName(),
+		"all_pages":    getQueryAllPages(),
+		"skip":         getQuerySkip(),
+		"take":         getQueryTake(),
+		"space_id":     getSpaceIDSchema(),
+	}
+}
+
+func getMachineProxySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"host": {
+			Description:      "The DNS hostname or IP address of the proxy.",
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"id":   getIDSchema(),
+		"name": getNameSchema(true),
+		"password": {
+			Description: "The password used to authenticate with the proxy.",
+			Optional:    true,
+			Sensitive:   true,
+			Type:        schema.TypeString,
+		},
+		"port": {
+			Default:          80,
+			Description:      "The TCP port of the proxy. Defaults to `80`.",
+			Optional:         true,
+			Type:             schema.TypeInt,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IsPortNumber),
+		},
+		"space_id": getSpaceIDSchema(),
+		"username": {
+			Description: "The username used to authenticate with the proxy.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+	}
+}
+
+func setMachineProxy(ctx context.Context, d *schema.ResourceData, proxy *proxies.Proxy) error {
+	d.Set("host", proxy.Host)
+	d.Set("name", proxy.Name)
+	d.Set("port", proxy.Port)
+	d.Set("space_id", proxy.SpaceID)
+	d.Set("username", proxy.Username)
+
+	d.SetId(proxy.GetID())
+
+	return nil
+}
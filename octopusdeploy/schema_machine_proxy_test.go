@@ -0,0 +1,63 @@
+package octopusdeploy
+
+import (
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/proxies"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandMachineProxy(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, getMachineProxySchema(), map[string]interface{}{
+		"host":     "proxy.dmz.example.com",
+		"name":     "DMZ Proxy",
+		"password": "secret",
+		"space_id": "Spaces-1",
+		"username": "octopus",
+	})
+
+	proxy := expandMachineProxy(d)
+	require.Equal(t, "DMZ Proxy", proxy.Name)
+	require.Equal(t, "proxy.dmz.example.com", proxy.Host)
+	require.Equal(t, 80, proxy.Port)
+	require.Equal(t, "HTTP", proxy.ProxyType)
+	require.Equal(t, "Spaces-1", proxy.SpaceID)
+	require.Equal(t, "octopus", proxy.Username)
+	require.Equal(t, core.NewSensitiveValue("secret"), proxy.Password)
+
+	d.SetId("Proxies-1")
+	require.Equal(t, "Proxies-1", expandMachineProxy(d).ID)
+}
+
+func TestFlattenMachineProxy(t *testing.T) {
+	proxy := proxies.NewProxy("DMZ Proxy", "proxy.dmz.example.com", "octopus", core.NewSensitiveValue("secret"))
+	proxy.ID = "Proxies-1"
+	proxy.Port = 3128
+
+	require.Equal(t, map[string]interface{}{
+		"host":     "proxy.dmz.example.com",
+		"id":       "Proxies-1",
+		"name":     "DMZ Proxy",
+		"port":     3128,
+		"space_id": "",
+		"username": "octopus",
+	}, flattenMachineProxy(proxy))
+	require.Nil(t, flattenMachineProxy(nil))
+}
+
+func TestCheckProxyID(t *testing.T) {
+	dmzProxy := proxies.NewProxy("DMZ Proxy", "proxy.dmz.example.com", "", nil)
+	dmzProxy.ID = "Proxies-1"
+	officeProxy := proxies.NewProxy("Office Proxy", "proxy.office.example.com", "", nil)
+	officeProxy.ID = "Proxies-2"
+
+	require.NoError(t, checkProxyID("Proxies-2", []*proxies.Proxy{dmzProxy, officeProxy}))
+
+	err := checkProxyID("Proxies-3", []*proxies.Proxy{officeProxy, dmzProxy})
+	require.EqualError(t, err, `proxy_id: no machine proxy with ID 'Proxies-3' was found; available machine proxies are "DMZ Proxy" (Proxies-1), "Office Proxy" (Proxies-2)`)
+
+	err = checkProxyID("Proxies-1", nil)
+	require.EqualError(t, err, "proxy_id: no machine proxy with ID 'Proxies-1' was found; the space has no machine proxies")
+}
@@ -0,0 +1,119 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/oidcaccounts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func expandOpenIDConnectAccount(d *schema.ResourceData, account *oidcaccounts.Account) {
+	account.ID = d.Id()
+	account.AccountTestSubjectKeys = getSliceFromTerraformTypeList(d.Get("account_test_subject_keys"))
+	account.DeploymentSubjectKeys = getSliceFromTerraformTypeList(d.Get("execution_subject_keys"))
+	account.HealthCheckSubjectKeys = getSliceFromTerraformTypeList(d.Get("health_subject_keys"))
+
+	if v, ok := d.GetOk("description"); ok {
+		account.Description = v.(string)
+	}
+
+	if v, ok := d.GetOk("environments"); ok {
+		account.EnvironmentIDs = getSliceFromTerraformTypeList(v)
+	}
+
+	if v, ok := d.GetOk("space_id"); ok {
+		account.SpaceID = v.(string)
+	}
+
+	if v, ok := d.GetOk("tenanted_deployment_participation"); ok {
+		account.TenantedDeploymentMode = core.TenantedDeploymentMode(v.(string))
+	}
+
+	if v, ok := d.GetOk("tenant_tags"); ok {
+		account.TenantTags = getSliceFromTerraformTypeList(v)
+	}
+
+	if v, ok := d.GetOk("tenants"); ok {
+		account.TenantIDs = getSliceFromTerraformTypeList(v)
+	}
+}
+
+// getOpenIDConnectAccountSchema returns the attributes that are shared by the accounts that authenticate with OpenID
+// Connect. The schemas of the account types add the attributes of their cloud providers to it.
+func getOpenIDConnectAccountSchema(resourceName string, accountSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	sharedSchema := map[string]*schema.Schema{
+		"account_test_subject_keys":         getSubjectKeysSchema("account tests", []string{"space", "account", "type"}),
+		"description":                       getDescriptionSchema(resourceName),
+		"environments":                      getEnvironmentsSchema(),
+		"execution_subject_keys":            getSubjectKeysSchema("deployments and runbook runs", []string{"space", "environment", "project", "tenant", "runbook", "account", "type"}),
+		"health_subject_keys":               getSubjectKeysSchema("health checks", []string{"space", "account", "target", "type"}),
+		"id":                                getIDSchema(),
+		"last_modified_by":                  getLastModifiedBySchema(),
+		"last_modified_on":                  getLastModifiedOnSchema(),
+		"name":                              getNameSchema(true),
+		"space_id":                          getSpaceIDSchema(),
+		"tenanted_deployment_participation": getTenantedDeploymentSchema(),
+		"tenants":                           getTenantsSchema(),
+		"tenant_tags":                       getTenantTagsSchema(),
+	}
+
+	for key, value := range accountSchema {
+		sharedSchema[key] = value
+	}
+
+	return sharedSchema
+}
+
+func getSubjectKeysSchema(usage string, keys []string) *schema.Schema {
+	return &schema.Schema{
+		Description: fmt.Sprintf("The keys to include in the subject of the OIDC tokens that are issued for %s. Valid keys are `%s`.", usage, strings.Join(keys, "`, `")),
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(keys, false)),
+		},
+		Optional: true,
+		Type:     schema.TypeList,
+	}
+}
+
+func setOpenIDConnectAccount(d *schema.ResourceData, account *oidcaccounts.Account) error {
+	d.Set("description", account.Description)
+	d.Set("name", account.Name)
+	d.Set("space_id", account.SpaceID)
+	d.Set("tenanted_deployment_participation", account.TenantedDeploymentMode)
+
+	if err := d.Set("account_test_subject_keys", account.AccountTestSubjectKeys); err != nil {
+		return fmt.Errorf("error setting account_test_subject_keys: %s", err)
+	}
+
+	if err := d.Set("environments", account.EnvironmentIDs); err != nil {
+		return fmt.Errorf("error setting environments: %s", err)
+	}
+
+	if err := d.Set("execution_subject_keys", account.DeploymentSubjectKeys); err != nil {
+		return fmt.Errorf("error setting execution_subject_keys: %s", err)
+	}
+
+	if err := d.Set("health_subject_keys", account.HealthCheckSubjectKeys); err != nil {
+		return fmt.Errorf("error setting health_subject_keys: %s", err)
+	}
+
+	if err := d.Set("tenants", account.TenantIDs); err != nil {
+		return fmt.Errorf("error setting tenants: %s", err)
+	}
+
+	if err := d.Set("tenant_tags", account.TenantTags); err != nil {
+		return fmt.Errorf("error setting tenant_tags: %s", err)
+	}
+
+	if err := setAccountModification(d, account); err != nil {
+		return err
+	}
+
+	d.SetId(account.GetID())
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package octopusdeploy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/oidcaccounts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandAmazonWebServicesOpenIDConnectAccount(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, getAmazonWebServicesOpenIDConnectAccountSchema(), map[string]interface{}{
+		"execution_subject_keys": []interface{}{"space", "project"},
+		"name":                   "aws-oidc",
+		"role_arn":               "arn:aws:iam::123456789012:role/octopus",
+		"space_id":               "Spaces-1",
+	})
+
+	account := expandAmazonWebServicesOpenIDConnectAccount(d)
+	require.Equal(t, oidcaccounts.AccountTypeAmazonWebServicesOIDC, account.AccountType)
+	require.Equal(t, "aws-oidc", account.Name)
+	require.Equal(t, "arn:aws:iam::123456789012:role/octopus", account.RoleArn)
+	require.Equal(t, oidcaccounts.DefaultSessionDuration, account.SessionDuration)
+	require.Equal(t, "Spaces-1", account.SpaceID)
+	require.Equal(t, []string{"space", "project"}, account.DeploymentSubjectKeys)
+	require.Empty(t, account.HealthCheckSubjectKeys)
+	require.Empty(t, account.AccountTestSubjectKeys)
+}
+
+func TestExpandAzureOpenIDConnectAccount(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, getAzureOpenIDConnectAccountSchema(), map[string]interface{}{
+		"application_id":      "00000000-0000-0000-0000-000000000001",
+		"health_subject_keys": []interface{}{"target"},
+		"name":                "azure-oidc",
+		"subscription_id":     "00000000-0000-0000-0000-000000000002",
+		"tenant_id":           "00000000-0000-0000-0000-000000000003",
+	})
+
+	account := expandAzureOpenIDConnectAccount(d)
+	require.Equal(t, oidcaccounts.AccountTypeAzureOIDC, account.AccountType)
+	require.Equal(t, "00000000-0000-0000-0000-000000000001", account.ApplicationID)
+	require.Equal(t, oidcaccounts.DefaultAzureAudience, account.Audience)
+	require.Equal(t, "00000000-0000-0000-0000-000000000002", account.SubscriptionID)
+	require.Equal(t, "00000000-0000-0000-0000-000000000003", account.TenantID)
+	require.Equal(t, []string{"target"}, account.HealthCheckSubjectKeys)
+}
+
+func TestSetGoogleCloudPlatformOpenIDConnectAccount(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, getGoogleCloudPlatformOpenIDConnectAccountSchema(), map[string]interface{}{})
+
+	account := oidcaccounts.NewGoogleCloudOIDCAccount("gcp-oidc", "//iam.googleapis.com/projects/1/locations/global/workloadIdentityPools/octopus/providers/octopus")
+	account.AccountTestSubjectKeys = []string{"account"}
+	account.ID = "Accounts-1"
+	account.ModifiedBy = "users-1"
+	account.SpaceID = "Spaces-1"
+
+	require.NoError(t, setGoogleCloudPlatformOpenIDConnectAccount(context.Background(), d, account))
+	require.Equal(t, "Accounts-1", d.Id())
+	require.Equal(t, account.Audience, d.Get("audience"))
+	require.Equal(t, "gcp-oidc", d.Get("name"))
+	require.Equal(t, "Spaces-1", d.Get("space_id"))
+	require.Equal(t, "users-1", d.Get("last_modified_by"))
+	require.Equal(t, []interface{}{"account"}, d.Get("account_test_subject_keys"))
+}
+
+func TestOpenIDConnectAccountSchemasAreValid(t *testing.T) {
+	for _, resource := range []*schema.Resource{
+		resourceAmazonWebServicesOpenIDConnectAccount(),
+		resourceAzureOpenIDConnectAccount(),
+		resourceGoogleCloudPlatformOpenIDConnectAccount(),
+	} {
+		require.NoError(t, resource.InternalValidate(nil, true))
+	}
+}
@@ -214,8 +214,9 @@ func getProjectDataSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Type:        schema.TypeList,
 		},
-		"skip": getQuerySkip(),
-		"take": getQueryTake(),
+		"all_pages": getQueryAllPages(),
+		"skip":      getQuerySkip(),
+		"take":      getQueryTake(),
 	}
 }
 
@@ -38,3 +38,44 @@ func flattenProjectEnvironments(projectEnvironments map[string][]string) []inter
 
 	return flattenedProjectEnvironments
 }
+
+// filterProjectEnvironments returns the project environments of the input projects.
+func filterProjectEnvironments(projectEnvironments map[string][]string, projectIDs []string) map[string][]string {
+	filteredProjectEnvironments := map[string][]string{}
+	for _, projectID := range projectIDs {
+		if environments, ok := projectEnvironments[projectID]; ok {
+			filteredProjectEnvironments[projectID] = environments
+		}
+	}
+
+	return filteredProjectEnvironments
+}
+
+// getProjectEnvironmentProjectIDs returns the project IDs of a project_environment set.
+func getProjectEnvironmentProjectIDs(value interface{}) []string {
+	projectIDs := []string{}
+	for projectID := range expandProjectEnvironments(value) {
+		projectIDs = append(projectIDs, projectID)
+	}
+
+	return projectIDs
+}
+
+// mergeProjectEnvironments returns the project environments of a tenant that only manages some of its connections: the
+// existing connections to projects that it has never managed, along with the connections that it manages now.
+func mergeProjectEnvironments(existingProjectEnvironments map[string][]string, managedProjectEnvironments map[string][]string, previouslyManagedProjectIDs []string) map[string][]string {
+	mergedProjectEnvironments := map[string][]string{}
+	for projectID, environments := range existingProjectEnvironments {
+		mergedProjectEnvironments[projectID] = environments
+	}
+
+	for _, projectID := range previouslyManagedProjectIDs {
+		delete(mergedProjectEnvironments, projectID)
+	}
+
+	for projectID, environments := range managedProjectEnvironments {
+		mergedProjectEnvironments[projectID] = environments
+	}
+
+	return mergedProjectEnvironments
+}
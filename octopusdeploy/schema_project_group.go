@@ -58,8 +58,9 @@ func getProjectGroupDataSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Type:        schema.TypeList,
 		},
-		"skip": getQuerySkip(),
-		"take": getQueryTake(),
+		"all_pages": getQueryAllPages(),
+		"skip":      getQuerySkip(),
+		"take":      getQueryTake(),
 	}
 }
 
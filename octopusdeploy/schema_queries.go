@@ -7,14 +7,18 @@ import (
 
 func getQueryAccountType() *schema.Schema {
 	return &schema.Schema{
-		Description: "A filter to search by a list of account types.  Valid account types are `AmazonWebServicesAccount`, `AmazonWebServicesRoleAccount`, `AzureServicePrincipal`, `AzureSubscription`, `None`, `SshKeyPair`, `Token`, or `UsernamePassword`.",
+		Description: "A filter to search by a list of account types.  Valid account types are `AmazonWebServicesAccount`, `AmazonWebServicesOidcAccount`, `AmazonWebServicesRoleAccount`, `AzureOIDC`, `AzureServicePrincipal`, `AzureSubscription`, `GoogleCloudAccount`, `GoogleCloudOidcAccount`, `None`, `SshKeyPair`, `Token`, or `UsernamePassword`.",
 		Optional:    true,
 		Type:        schema.TypeString,
 		ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
 			"AmazonWebServicesAccount",
+			"AmazonWebServicesOidcAccount",
 			"AmazonWebServicesRoleAccount",
+			"AzureOIDC",
 			"AzureServicePrincipal",
 			"AzureSubscription",
+			"GoogleCloudAccount",
+			"GoogleCloudOidcAccount",
 			"None",
 			"SshKeyPair",
 			"Token",
@@ -23,6 +27,14 @@ func getQueryAccountType() *schema.Schema {
 	}
 }
 
+func getQueryAllPages() *schema.Schema {
+	return &schema.Schema{
+		Description: "Whether to return every page of results by following the next page links of the collection. Defaults to `true` when `take` is not set; `take` then sets the page size.",
+		Optional:    true,
+		Type:        schema.TypeBool,
+	}
+}
+
 func getQueryArchived() *schema.Schema {
 	return &schema.Schema{
 		Description: "A filter to search for resources that have been archived.",
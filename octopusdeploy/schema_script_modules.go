@@ -90,6 +90,7 @@ func getScriptModuleDataSchema() map[string]*schema.Schema {
 			Type:        schema.TypeList,
 		},
 		"partial_name": getQueryPartialName(),
+		"all_pages":    getQueryAllPages(),
 		"skip":         getQuerySkip(),
 		"take":         getQueryTake(),
 	}
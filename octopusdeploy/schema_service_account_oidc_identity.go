@@ -0,0 +1,128 @@
+package octopusdeploy
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/users"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/serviceaccounts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func expandServiceAccountOIDCIdentity(d *schema.ResourceData) *serviceaccounts.OIDCIdentity {
+	identity := serviceaccounts.NewOIDCIdentity(
+		d.Get("service_account_id").(string),
+		d.Get("name").(string),
+		d.Get("issuer").(string),
+		d.Get("subject").(string),
+	)
+	identity.ID = d.Id()
+
+	if v, ok := d.GetOk("audience"); ok {
+		identity.Audience = v.(string)
+	}
+
+	return identity
+}
+
+// flattenServiceAccountOIDCIdentity converts an OIDC identity into its HCL representation. The issuer, subject and
+// audience are also reported as the identifying claims of the identity, in the same form as the identities of users.
+func flattenServiceAccountOIDCIdentity(identity *serviceaccounts.OIDCIdentity, externalID string) map[string]interface{} {
+	if identity == nil {
+		return nil
+	}
+
+	audience := identity.Audience
+	if len(audience) == 0 {
+		audience = externalID
+	}
+
+	claims := map[string]users.IdentityClaim{
+		"aud": {IsIdentifyingClaim: true, Value: audience},
+		"iss": {IsIdentifyingClaim: true, Value: identity.Issuer},
+		"sub": {IsIdentifyingClaim: true, Value: identity.Subject},
+	}
+
+	return map[string]interface{}{
+		"audience":           audience,
+		"claim":              flattenIdentityClaims(claims),
+		"id":                 identity.ID,
+		"issuer":             identity.Issuer,
+		"name":               identity.Name,
+		"service_account_id": identity.ServiceAccountID,
+		"subject":            identity.Subject,
+	}
+}
+
+func getServiceAccountOIDCIdentitiesDataSchema() map[string]*schema.Schema {
+	identitySchema := getServiceAccountOIDCIdentitySchema()
+	identitySchema["claim"] = &schema.Schema{
+		Description: "The claims that an OIDC token must contain to be exchanged for access as the service account.",
+		Elem:        &schema.Resource{Schema: getIdentityClaimSchema()},
+		Type:        schema.TypeSet,
+	}
+	setDataSchema(&identitySchema)
+
+	return map[string]*schema.Schema{
+		"external_id": {
+			Computed:    true,
+			Description: "The external ID of the service account. This is the audience that CI systems should request for OIDC tokens that are exchanged for access to Octopus Deploy.",
+			Type:        schema.TypeString,
+		},
+		"id": getDataSchemaID(),
+		"oidc_identities": {
+			Computed:    true,
+			Description: "A list of the OIDC identities of the service account.",
+			Elem:        &schema.Resource{Schema: identitySchema},
+			Type:        schema.TypeList,
+		},
+		"service_account_id": {
+			Description: "The ID of the service account.",
+			Required:    true,
+			Type:        schema.TypeString,
+		},
+	}
+}
+
+func getServiceAccountOIDCIdentitySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"audience": {
+			Computed:    true,
+			Description: "The audience that OIDC tokens must be issued for. Defaults to the external ID of the service account.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"id": getIDSchema(),
+		"issuer": {
+			Description:      "The URL of the OIDC issuer (i.e. `https://token.actions.githubusercontent.com`).",
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IsURLWithHTTPS),
+		},
+		"name": getNameSchema(true),
+		"service_account_id": {
+			Description: "The ID of the service account (a user with `is_service` set) that the OIDC identity belongs to.",
+			ForceNew:    true,
+			Required:    true,
+			Type:        schema.TypeString,
+		},
+		"subject": {
+			Description:      "The subject that OIDC tokens must be issued for (i.e. `repo:octopusdeploy/example:environment:production`).",
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+		},
+	}
+}
+
+func setServiceAccountOIDCIdentity(ctx context.Context, d *schema.ResourceData, identity *serviceaccounts.OIDCIdentity, externalID string) {
+	flattenedIdentity := flattenServiceAccountOIDCIdentity(identity, externalID)
+
+	d.Set("audience", flattenedIdentity["audience"])
+	d.Set("issuer", identity.Issuer)
+	d.Set("name", identity.Name)
+	d.Set("service_account_id", identity.ServiceAccountID)
+	d.Set("subject", identity.Subject)
+
+	d.SetId(identity.ID)
+}
@@ -0,0 +1,93 @@
+package octopusdeploy
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/serviceaccounts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandServiceAccountOIDCIdentity(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, getServiceAccountOIDCIdentitySchema(), map[string]interface{}{
+		"issuer":             "https://token.actions.githubusercontent.com",
+		"name":               "GitHub Actions",
+		"service_account_id": "Users-1",
+		"subject":            "repo:octopusdeploy/example:environment:production",
+	})
+	d.SetId("ServiceAccountOidcIdentities-1")
+
+	identity := expandServiceAccountOIDCIdentity(d)
+	require.Equal(t, "ServiceAccountOidcIdentities-1", identity.ID)
+	require.Equal(t, "https://token.actions.githubusercontent.com", identity.Issuer)
+	require.Equal(t, "Users-1", identity.ServiceAccountID)
+	require.Empty(t, identity.Audience)
+}
+
+func TestFlattenServiceAccountOIDCIdentity(t *testing.T) {
+	identity := serviceaccounts.NewOIDCIdentity("Users-1", "GitHub Actions", "https://token.actions.githubusercontent.com", "repo:octopusdeploy/example:ref:refs/heads/main")
+
+	flattenedIdentity := flattenServiceAccountOIDCIdentity(identity, "external-id")
+	require.Equal(t, "external-id", flattenedIdentity["audience"])
+
+	claims := map[string]string{}
+	for _, claim := range flattenedIdentity["claim"].([]interface{}) {
+		claimMap := claim.(map[string]interface{})
+		require.True(t, claimMap["is_identifying_claim"].(bool))
+		claims[claimMap["name"].(string)] = claimMap["value"].(string)
+	}
+	require.Equal(t, map[string]string{
+		"aud": "external-id",
+		"iss": "https://token.actions.githubusercontent.com",
+		"sub": "repo:octopusdeploy/example:ref:refs/heads/main",
+	}, claims)
+
+	identity.Audience = "api://octopus"
+	require.Equal(t, "api://octopus", flattenServiceAccountOIDCIdentity(identity, "external-id")["audience"])
+	require.Nil(t, flattenServiceAccountOIDCIdentity(nil, "external-id"))
+}
+
+func TestGetAllServiceAccountOIDCIdentitiesReadsEveryPage(t *testing.T) {
+	const count = 7
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/serviceaccounts/Users-1/oidcidentities/v1", r.URL.Path)
+		requests++
+
+		// the server returns fewer identities than requested, as it does when the requested page is too large
+		skip, _ := strconv.Atoi(r.URL.Query().Get("skip"))
+		identities := serviceaccounts.OIDCIdentities{Count: count, ExternalID: "external-id", OIDCIdentities: []*serviceaccounts.OIDCIdentity{}}
+		for i := skip; i < count && i < skip+testPageSize; i++ {
+			identity := serviceaccounts.NewOIDCIdentity("Users-1", fmt.Sprintf("Identity %d", i+1), "https://example.com", "subject")
+			identity.ID = fmt.Sprintf("ServiceAccountOidcIdentities-%d", i+1)
+			identities.OIDCIdentities = append(identities.OIDCIdentities, identity)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(identities))
+	}))
+	t.Cleanup(server.Close)
+
+	baseURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	client := newclient.NewClientS(&newclient.HttpSession{
+		BaseURL:    baseURL,
+		HttpClient: server.Client(),
+	}, "Spaces-1")
+
+	identities, err := serviceaccounts.GetAll(client, "Users-1")
+	require.NoError(t, err)
+	require.Equal(t, "external-id", identities.ExternalID)
+	require.Len(t, identities.OIDCIdentities, count)
+	require.Equal(t, "ServiceAccountOidcIdentities-7", identities.OIDCIdentities[count-1].ID)
+	require.Equal(t, 3, requests)
+}
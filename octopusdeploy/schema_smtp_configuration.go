@@ -0,0 +1,81 @@
+package octopusdeploy
+
+import (
+	"context"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/serverconfiguration"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func expandSMTPConfiguration(d *schema.ResourceData) *serverconfiguration.SMTPConfiguration {
+	return &serverconfiguration.SMTPConfiguration{
+		EnableSSL:     d.Get("enable_ssl").(bool),
+		SendEmailFrom: d.Get("send_email_from").(string),
+		SMTPHost:      d.Get("host").(string),
+		SMTPLogin:     d.Get("login").(string),
+		SMTPPassword:  expandChangedSensitiveValue(d, "password"),
+		SMTPPort:      d.Get("port").(int),
+		Timeout:       d.Get("timeout").(int),
+	}
+}
+
+func getSMTPConfigurationSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"enable_ssl": {
+			Default:     false,
+			Description: "Indicates whether the connection to the SMTP server uses SSL/TLS.",
+			Optional:    true,
+			Type:        schema.TypeBool,
+		},
+		"host": {
+			Description:      "The host name of the SMTP server.",
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+		},
+		"id": getIDSchema(),
+		"login": {
+			Description: "The user name used to authenticate with the SMTP server.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+		"password": {
+			Description: "The password used to authenticate with the SMTP server. The server never returns the password, so changes made outside of Terraform are not detected.",
+			Optional:    true,
+			Sensitive:   true,
+			Type:        schema.TypeString,
+		},
+		"port": {
+			Default:          25,
+			Description:      "The port of the SMTP server.",
+			Optional:         true,
+			Type:             schema.TypeInt,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IsPortNumber),
+		},
+		"send_email_from": {
+			Description:      "The address that emails are sent from.",
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+		},
+		"timeout": {
+			Default:          12000,
+			Description:      "The number of milliseconds to wait for the SMTP server to respond.",
+			Optional:         true,
+			Type:             schema.TypeInt,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+		},
+	}
+}
+
+func setSMTPConfiguration(ctx context.Context, d *schema.ResourceData, configuration *serverconfiguration.SMTPConfiguration) {
+	d.Set("enable_ssl", configuration.EnableSSL)
+	d.Set("host", configuration.SMTPHost)
+	d.Set("login", configuration.SMTPLogin)
+	d.Set("port", configuration.SMTPPort)
+	d.Set("send_email_from", configuration.SendEmailFrom)
+	d.Set("timeout", configuration.Timeout)
+
+	d.SetId(serverconfiguration.SMTPConfigurationID)
+}
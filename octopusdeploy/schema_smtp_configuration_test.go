@@ -0,0 +1,56 @@
+package octopusdeploy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/serverconfiguration"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandSMTPConfiguration(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, getSMTPConfigurationSchema(), map[string]interface{}{
+		"enable_ssl":      true,
+		"host":            "smtp.example.com",
+		"login":           "octopus",
+		"password":        "secret",
+		"port":            587,
+		"send_email_from": "octopus@example.com",
+	})
+
+	configuration := expandSMTPConfiguration(d)
+	require.True(t, configuration.EnableSSL)
+	require.Equal(t, "smtp.example.com", configuration.SMTPHost)
+	require.Equal(t, "octopus", configuration.SMTPLogin)
+	require.Equal(t, 587, configuration.SMTPPort)
+	require.Equal(t, "octopus@example.com", configuration.SendEmailFrom)
+	require.Equal(t, 12000, configuration.Timeout)
+	require.True(t, configuration.SMTPPassword.HasValue)
+	require.Equal(t, "secret", *configuration.SMTPPassword.NewValue)
+
+	setSMTPConfiguration(context.Background(), d, configuration)
+	require.Equal(t, serverconfiguration.SMTPConfigurationID, d.Id())
+	require.Equal(t, "secret", d.Get("password"))
+}
+
+func TestDefaultSMTPConfigurationClearsValues(t *testing.T) {
+	values := map[string]interface{}{
+		"EnableSsl":     true,
+		"SendEmailFrom": "octopus@example.com",
+		"SmtpHost":      "smtp.example.com",
+		"SmtpLogin":     "octopus",
+		"SmtpPassword":  map[string]interface{}{"HasValue": true},
+		"SmtpPort":      587,
+		"Timeout":       60000,
+	}
+
+	require.NoError(t, serverconfiguration.MergeValues(values, serverconfiguration.NewDefaultSMTPConfiguration()))
+	require.Equal(t, false, values["EnableSsl"])
+	require.Equal(t, "", values["SendEmailFrom"])
+	require.Equal(t, "", values["SmtpHost"])
+	require.Equal(t, "", values["SmtpLogin"])
+	require.Equal(t, map[string]interface{}{"HasValue": false, "Hint": nil, "NewValue": nil}, values["SmtpPassword"])
+	require.Equal(t, float64(25), values["SmtpPort"])
+	require.Equal(t, float64(12000), values["Timeout"])
+}
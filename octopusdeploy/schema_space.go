@@ -73,6 +73,8 @@ func flattenSpace(space *spaces.Space) map[string]interface{} {
 func getSpaceDataSourceSchema() map[string]*schema.Schema {
 	dataSchema := getSpaceSchema()
 	setDataSchema(&dataSchema)
+	delete(dataSchema, "drain_before_destroy")
+	delete(dataSchema, "prevent_destroy_if_not_empty")
 
 	dataSchema["name"] = getNameSchemaWithMaxLength(true, 20)
 
@@ -82,11 +84,14 @@ func getSpaceDataSourceSchema() map[string]*schema.Schema {
 func getSpacesDataSourceSchema() map[string]*schema.Schema {
 	dataSchema := getSpaceSchema()
 	setDataSchema(&dataSchema)
+	delete(dataSchema, "drain_before_destroy")
+	delete(dataSchema, "prevent_destroy_if_not_empty")
 
 	return map[string]*schema.Schema{
 		"id":           getDataSchemaID(),
 		"ids":          getQueryIDs(),
 		"partial_name": getQueryPartialName(),
+		"all_pages":    getQueryAllPages(),
 		"skip":         getQuerySkip(),
 		"take":         getQueryTake(),
 		"spaces": {
@@ -102,13 +107,25 @@ func getSpacesDataSourceSchema() map[string]*schema.Schema {
 func getSpaceSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"description": getDescriptionSchema("space"),
-		"id":          getIDSchema(),
+		"drain_before_destroy": {
+			Default:     false,
+			Description: "Indicates whether destroying this space waits for its queued and running tasks to complete, after stopping its task queue. The wait is limited by the delete timeout of this resource (30 minutes by default).",
+			Optional:    true,
+			Type:        schema.TypeBool,
+		},
+		"id": getIDSchema(),
 		"is_default": {
 			Description: "Specifies if this space is the default space in Octopus.",
 			Optional:    true,
 			Type:        schema.TypeBool,
 		},
 		"name": getNameSchemaWithMaxLength(true, 20),
+		"prevent_destroy_if_not_empty": {
+			Default:     false,
+			Description: "Indicates whether destroying this space fails while it contains projects, deployment targets or feeds.",
+			Optional:    true,
+			Type:        schema.TypeBool,
+		},
 		"slug": {
 			Computed:    true,
 			Description: "The unique slug of this space.",
@@ -98,8 +98,9 @@ func getSSHConnectionDeploymentTargetSchema() map[string]*schema.Schema {
 	}
 
 	sshConnectionDeploymentTargetSchema["proxy_id"] = &schema.Schema{
-		Optional: true,
-		Type:     schema.TypeString,
+		Description: "The ID of the machine proxy (i.e. an `octopusdeploy_machine_proxy`) that Octopus Deploy connects to this deployment target through. The ID is validated against the machine proxies of the space when the plan is made.",
+		Optional:    true,
+		Type:        schema.TypeString,
 	}
 
 	return sshConnectionDeploymentTargetSchema
@@ -13,10 +13,11 @@ import (
 func expandSSHKeyAccount(d *schema.ResourceData) *accounts.SSHKeyAccount {
 	name := d.Get("name").(string)
 	username := d.Get("username").(string)
-	privateKeyFile := core.NewSensitiveValue(d.Get("private_key_passphrase").(string))
+	privateKeyFile := expandAccountSecret(d, "private_key_file")
 
 	account, _ := accounts.NewSSHKeyAccount(name, username, privateKeyFile)
 	account.ID = d.Id()
+	account.PrivateKeyPassphrase = expandAccountSecret(d, "private_key_passphrase")
 
 	if v, ok := d.GetOk("tenanted_deployment_participation"); ok {
 		account.TenantedDeploymentMode = core.TenantedDeploymentMode(v.(string))
@@ -34,7 +35,7 @@ func expandSSHKeyAccount(d *schema.ResourceData) *accounts.SSHKeyAccount {
 }
 
 func getSSHKeyAccountSchema() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
+	return addAccountCredentialSchema(map[string]*schema.Schema{
 		"description":  getDescriptionSchema("SSH key account"),
 		"environments": getEnvironmentsSchema(),
 		"id":           getIDSchema(),
@@ -55,7 +56,7 @@ func getSSHKeyAccountSchema() map[string]*schema.Schema {
 		"tenants":                           getTenantsSchema(),
 		"tenant_tags":                       getTenantTagsSchema(),
 		"username":                          getUsernameSchema(true),
-	}
+	}, "private_key_file", "private_key_passphrase")
 }
 
 func setSSHKeyAccount(ctx context.Context, d *schema.ResourceData, account *accounts.SSHKeyAccount) error {
@@ -79,5 +80,9 @@ func setSSHKeyAccount(ctx context.Context, d *schema.ResourceData, account *acco
 
 	d.Set("username", account.Username)
 
+	if err := setAccountModification(d, account); err != nil {
+		return err
+	}
+
 	return nil
 }
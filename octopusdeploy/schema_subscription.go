@@ -0,0 +1,524 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/subscriptions"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func expandSubscription(d *schema.ResourceData) *subscriptions.Subscription {
+	name := d.Get("name").(string)
+
+	subscription := subscriptions.NewSubscription(name)
+	subscription.ID = d.Id()
+
+	if v, ok := d.GetOk("is_disabled"); ok {
+		subscription.IsDisabled = v.(bool)
+	}
+
+	if v, ok := d.GetOk("space_id"); ok {
+		subscription.SpaceID = v.(string)
+	}
+
+	filter := &subscription.EventNotificationSubscription.Filter
+	if v, ok := d.GetOk("document_types"); ok {
+		filter.DocumentTypes = getSliceFromTerraformTypeList(v)
+	}
+
+	if v, ok := d.GetOk("environment_ids"); ok {
+		filter.Environments = getSliceFromTerraformTypeList(v)
+	}
+
+	if v, ok := d.GetOk("event_agents"); ok {
+		filter.EventAgents = getSliceFromTerraformTypeList(v)
+	}
+
+	if v, ok := d.GetOk("event_categories"); ok {
+		filter.EventCategories = getSliceFromTerraformTypeList(v)
+	}
+
+	if v, ok := d.GetOk("event_groups"); ok {
+		filter.EventGroups = getSliceFromTerraformTypeList(v)
+	}
+
+	if v, ok := d.GetOk("project_group_ids"); ok {
+		filter.ProjectGroups = getSliceFromTerraformTypeList(v)
+	}
+
+	if v, ok := d.GetOk("project_ids"); ok {
+		filter.Projects = getSliceFromTerraformTypeList(v)
+	}
+
+	if v, ok := d.GetOk("tenant_ids"); ok {
+		filter.Tenants = getSliceFromTerraformTypeList(v)
+	}
+
+	if v, ok := d.GetOk("tenant_tags"); ok {
+		filter.Tags = getSliceFromTerraformTypeList(v)
+	}
+
+	if v, ok := d.GetOk("user_ids"); ok {
+		filter.Users = getSliceFromTerraformTypeList(v)
+	}
+
+	if v, ok := d.GetOk("email_digest"); ok {
+		expandSubscriptionEmailDigest(v.([]interface{}), &subscription.EventNotificationSubscription)
+	}
+
+	if v, ok := d.GetOk("webhook"); ok {
+		expandSubscriptionWebhook(v.([]interface{}), &subscription.EventNotificationSubscription)
+	}
+
+	return subscription
+}
+
+func expandSubscriptionEmailDigest(values []interface{}, notification *subscriptions.EventNotificationSubscription) {
+	if len(values) == 0 || values[0] == nil {
+		return
+	}
+
+	emailDigest := values[0].(map[string]interface{})
+
+	if v, ok := emailDigest["frequency"]; ok {
+		if frequency, err := time.ParseDuration(v.(string)); err == nil {
+			notification.EmailFrequencyPeriod = formatTimeSpan(frequency)
+		}
+	}
+
+	if v, ok := emailDigest["priority"]; ok {
+		notification.EmailPriority = v.(string)
+	}
+
+	if v, ok := emailDigest["team_ids"]; ok {
+		notification.EmailTeams = getSliceFromTerraformTypeList(v)
+	}
+
+	if v, ok := emailDigest["time_zone"]; ok {
+		notification.EmailShowDatesInTimeZoneID = v.(string)
+	}
+}
+
+func expandSubscriptionWebhook(values []interface{}, notification *subscriptions.EventNotificationSubscription) {
+	if len(values) == 0 || values[0] == nil {
+		return
+	}
+
+	webhook := values[0].(map[string]interface{})
+
+	if v, ok := webhook["header"]; ok {
+		for _, header := range v.([]interface{}) {
+			if header == nil {
+				continue
+			}
+			rawHeader := header.(map[string]interface{})
+			notification.WebhookHeaderKey = rawHeader["key"].(string)
+			notification.WebhookHeaderValue = rawHeader["value"].(string)
+		}
+	}
+
+	if v, ok := webhook["team_ids"]; ok {
+		notification.WebhookTeams = getSliceFromTerraformTypeList(v)
+	}
+
+	if v, ok := webhook["timeout"]; ok {
+		notification.WebhookTimeout = formatTimeSpan(time.Duration(v.(int)) * time.Second)
+	}
+
+	if v, ok := webhook["url"]; ok {
+		notification.WebhookURI = v.(string)
+	}
+}
+
+func flattenSubscriptionEmailDigest(notification subscriptions.EventNotificationSubscription) []interface{} {
+	frequency := ""
+	if v, err := parseTimeSpan(notification.EmailFrequencyPeriod); err == nil {
+		frequency = v.String()
+	}
+
+	return []interface{}{map[string]interface{}{
+		"frequency": frequency,
+		"priority":  notification.EmailPriority,
+		"team_ids":  notification.EmailTeams,
+		"time_zone": notification.EmailShowDatesInTimeZoneID,
+	}}
+}
+
+// flattenSubscriptionWebhook converts the webhook settings of a subscription into their HCL representation. The value
+// of the header is taken from the configuration when the server does not return it.
+func flattenSubscriptionWebhook(notification subscriptions.EventNotificationSubscription, existingWebhook []interface{}) []interface{} {
+	timeout := 0
+	if v, err := parseTimeSpan(notification.WebhookTimeout); err == nil {
+		timeout = int(v / time.Second)
+	}
+
+	headers := []interface{}{}
+	if len(notification.WebhookHeaderKey) > 0 {
+		headerValue := notification.WebhookHeaderValue
+		if len(headerValue) == 0 {
+			headerValue = getExistingSubscriptionWebhookHeaderValue(existingWebhook, notification.WebhookHeaderKey)
+		}
+
+		headers = append(headers, map[string]interface{}{
+			"key":   notification.WebhookHeaderKey,
+			"value": headerValue,
+		})
+	}
+
+	return []interface{}{map[string]interface{}{
+		"header":   headers,
+		"team_ids": notification.WebhookTeams,
+		"timeout":  timeout,
+		"url":      notification.WebhookURI,
+	}}
+}
+
+func getExistingSubscriptionWebhookHeaderValue(existingWebhook []interface{}, key string) string {
+	if len(existingWebhook) == 0 || existingWebhook[0] == nil {
+		return ""
+	}
+
+	headers, ok := existingWebhook[0].(map[string]interface{})["header"].([]interface{})
+	if !ok {
+		return ""
+	}
+
+	for _, header := range headers {
+		if header == nil {
+			continue
+		}
+
+		rawHeader := header.(map[string]interface{})
+		if rawHeader["key"] == key {
+			return rawHeader["value"].(string)
+		}
+	}
+
+	return ""
+}
+
+// formatTimeSpan converts a duration into the [d.]hh:mm:ss format used by the Octopus API for time spans.
+func formatTimeSpan(duration time.Duration) string {
+	duration = duration.Round(time.Second)
+
+	days := duration / (24 * time.Hour)
+	duration -= days * 24 * time.Hour
+	hours := duration / time.Hour
+	duration -= hours * time.Hour
+	minutes := duration / time.Minute
+	duration -= minutes * time.Minute
+	seconds := duration / time.Second
+
+	timeSpan := fmt.Sprintf("%02d:%02d:%02d", hours, minutes, seconds)
+	if days > 0 {
+		timeSpan = fmt.Sprintf("%d.%s", days, timeSpan)
+	}
+
+	return timeSpan
+}
+
+// parseTimeSpan converts a time span in the [d.]hh:mm:ss[.fffffff] format used by the Octopus API into a duration.
+func parseTimeSpan(timeSpan string) (time.Duration, error) {
+	var days time.Duration
+
+	parts := strings.Split(timeSpan, ":")
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("invalid time span %q", timeSpan)
+	}
+
+	if dayParts := strings.SplitN(parts[0], ".", 2); len(dayParts) == 2 {
+		v, err := strconv.Atoi(dayParts[0])
+		if err != nil {
+			return 0, fmt.Errorf("invalid time span %q", timeSpan)
+		}
+		days = time.Duration(v) * 24 * time.Hour
+		parts[0] = dayParts[1]
+	}
+
+	hours, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, fmt.Errorf("invalid time span %q", timeSpan)
+	}
+
+	minutes, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, fmt.Errorf("invalid time span %q", timeSpan)
+	}
+
+	seconds, err := strconv.ParseFloat(parts[2], 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time span %q", timeSpan)
+	}
+
+	return days + time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute + time.Duration(seconds*float64(time.Second)), nil
+}
+
+// suppressEquivalentDurationDiffs suppresses differences between durations that are written differently but have the
+// same length (i.e. `1h` and `60m`).
+func suppressEquivalentDurationDiffs(k, old, new string, d *schema.ResourceData) bool {
+	oldDuration, err := time.ParseDuration(old)
+	if err != nil {
+		return false
+	}
+
+	newDuration, err := time.ParseDuration(new)
+	if err != nil {
+		return false
+	}
+
+	return oldDuration == newDuration
+}
+
+func validateDuration(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	if _, err := time.ParseDuration(v); err != nil {
+		return nil, []error{fmt.Errorf("expected %q to be a duration (i.e. `1h` or `30m`), got %q", k, v)}
+	}
+
+	return nil, nil
+}
+
+func getSubscriptionSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"document_types": {
+			Description: "A list of document types (i.e. `Deployments` or `Machines`) to restrict the events that notifications are sent for.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Type:        schema.TypeList,
+		},
+		"email_digest": {
+			Description: "Sends a digest email of the matching events to the members of teams.",
+			Elem:        &schema.Resource{Schema: getSubscriptionEmailDigestSchema()},
+			MaxItems:    1,
+			Optional:    true,
+			Type:        schema.TypeList,
+		},
+		"environment_ids": {
+			Description: "A list of environment IDs to restrict the events that notifications are sent for.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Type:        schema.TypeList,
+		},
+		"event_agents": {
+			Description: "A list of event agents (i.e. `Trigger` or `Scheduler`) to restrict the events that notifications are sent for.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Type:        schema.TypeList,
+		},
+		"event_categories": {
+			Description: "Apply event category filters (i.e. `DeploymentFailed` or `LoginFailed`) to restrict the events that notifications are sent for.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Type:        schema.TypeList,
+		},
+		"event_groups": {
+			Description: "Apply event group filters (i.e. `Deployment` or `User`) to restrict the events that notifications are sent for.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Type:        schema.TypeList,
+		},
+		"id": getIDSchema(),
+		"is_disabled": {
+			Default:     false,
+			Description: "Indicates whether notifications of this subscription are paused.",
+			Optional:    true,
+			Type:        schema.TypeBool,
+		},
+		"name": getNameSchema(true),
+		"project_group_ids": {
+			Description: "A list of project group IDs to restrict the events that notifications are sent for.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Type:        schema.TypeList,
+		},
+		"project_ids": {
+			Description: "A list of project IDs to restrict the events that notifications are sent for.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Type:        schema.TypeList,
+		},
+		"space_id": getSpaceIDSchema(),
+		"tenant_ids": {
+			Description: "A list of tenant IDs to restrict the events that notifications are sent for.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Type:        schema.TypeList,
+		},
+		"tenant_tags": {
+			Description: "A list of tenant tags (i.e. `Regions/us-east`) to restrict the events that notifications are sent for.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Type:        schema.TypeList,
+		},
+		"user_ids": {
+			Description: "A list of user IDs to restrict the events that notifications are sent for to the ones caused by these users.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Type:        schema.TypeList,
+		},
+		"webhook": {
+			Description: "Sends each matching event to a webhook.",
+			Elem:        &schema.Resource{Schema: getSubscriptionWebhookSchema()},
+			MaxItems:    1,
+			Optional:    true,
+			Type:        schema.TypeList,
+		},
+	}
+}
+
+func getSubscriptionEmailDigestSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"frequency": {
+			Default:          "1h",
+			Description:      "How often the digest email is sent, as a duration (i.e. `1h` or `30m`).",
+			DiffSuppressFunc: suppressEquivalentDurationDiffs,
+			Optional:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validateDuration),
+		},
+		"priority": {
+			Default:          subscriptions.EmailPriorityNormal,
+			Description:      fmt.Sprintf("The priority of the digest email. Valid priorities are `%s` and `%s`.", subscriptions.EmailPriorityNormal, subscriptions.EmailPriorityHigh),
+			Optional:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{subscriptions.EmailPriorityHigh, subscriptions.EmailPriorityNormal}, false)),
+		},
+		"team_ids": {
+			Description: "A list of team IDs whose members receive the digest email.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Required:    true,
+			Type:        schema.TypeList,
+		},
+		"time_zone": {
+			Default:     "UTC",
+			Description: "The time zone (i.e. `Australia/Brisbane`) used to show the dates in the digest email.",
+			Optional:    true,
+			Type:        schema.TypeString,
+		},
+	}
+}
+
+func getSubscriptionWebhookSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"header": {
+			Description: "A header that is sent with each request to the webhook (i.e. an authorization header). Octopus Deploy supports a single webhook header.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"key": {
+						Description:      "The name of the header.",
+						Required:         true,
+						Type:             schema.TypeString,
+						ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+					},
+					"value": {
+						Description: "The value of the header.",
+						Required:    true,
+						Sensitive:   true,
+						Type:        schema.TypeString,
+					},
+				},
+			},
+			MaxItems: 1,
+			Optional: true,
+			Type:     schema.TypeList,
+		},
+		"team_ids": {
+			Description: "A list of team IDs to restrict the events that are sent to the webhook to the ones visible to these teams.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Type:        schema.TypeList,
+		},
+		"timeout": {
+			Default:          10,
+			Description:      "The number of seconds to wait for the webhook to respond.",
+			Optional:         true,
+			Type:             schema.TypeInt,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+		},
+		"url": {
+			Description:      "The URL of the webhook.",
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IsURLWithHTTPorHTTPS),
+		},
+	}
+}
+
+func setSubscription(ctx context.Context, d *schema.ResourceData, subscription *subscriptions.Subscription) error {
+	filter := subscription.EventNotificationSubscription.Filter
+	notification := subscription.EventNotificationSubscription
+
+	if err := d.Set("document_types", filter.DocumentTypes); err != nil {
+		return fmt.Errorf("error setting document_types: %s", err)
+	}
+
+	if len(notification.EmailTeams) > 0 {
+		if err := d.Set("email_digest", flattenSubscriptionEmailDigest(notification)); err != nil {
+			return fmt.Errorf("error setting email_digest: %s", err)
+		}
+	} else {
+		d.Set("email_digest", nil)
+	}
+
+	if err := d.Set("environment_ids", filter.Environments); err != nil {
+		return fmt.Errorf("error setting environment_ids: %s", err)
+	}
+
+	if err := d.Set("event_agents", filter.EventAgents); err != nil {
+		return fmt.Errorf("error setting event_agents: %s", err)
+	}
+
+	if err := d.Set("event_categories", filter.EventCategories); err != nil {
+		return fmt.Errorf("error setting event_categories: %s", err)
+	}
+
+	if err := d.Set("event_groups", filter.EventGroups); err != nil {
+		return fmt.Errorf("error setting event_groups: %s", err)
+	}
+
+	d.Set("is_disabled", subscription.IsDisabled)
+	d.Set("name", subscription.Name)
+
+	if err := d.Set("project_group_ids", filter.ProjectGroups); err != nil {
+		return fmt.Errorf("error setting project_group_ids: %s", err)
+	}
+
+	if err := d.Set("project_ids", filter.Projects); err != nil {
+		return fmt.Errorf("error setting project_ids: %s", err)
+	}
+
+	d.Set("space_id", subscription.SpaceID)
+
+	if err := d.Set("tenant_ids", filter.Tenants); err != nil {
+		return fmt.Errorf("error setting tenant_ids: %s", err)
+	}
+
+	if err := d.Set("tenant_tags", filter.Tags); err != nil {
+		return fmt.Errorf("error setting tenant_tags: %s", err)
+	}
+
+	if err := d.Set("user_ids", filter.Users); err != nil {
+		return fmt.Errorf("error setting user_ids: %s", err)
+	}
+
+	if len(notification.WebhookURI) > 0 {
+		if err := d.Set("webhook", flattenSubscriptionWebhook(notification, d.Get("webhook").([]interface{}))); err != nil {
+			return fmt.Errorf("error setting webhook: %s", err)
+		}
+	} else {
+		d.Set("webhook", nil)
+	}
+
+	d.SetId(subscription.GetID())
+
+	return nil
+}
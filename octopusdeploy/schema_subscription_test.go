@@ -0,0 +1,91 @@
+package octopusdeploy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/subscriptions"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatAndParseTimeSpan(t *testing.T) {
+	require.Equal(t, "00:00:10", formatTimeSpan(10*time.Second))
+	require.Equal(t, "01:30:00", formatTimeSpan(90*time.Minute))
+	require.Equal(t, "2.03:04:05", formatTimeSpan(51*time.Hour+4*time.Minute+5*time.Second))
+
+	for _, duration := range []time.Duration{10 * time.Second, 90 * time.Minute, 51*time.Hour + 4*time.Minute + 5*time.Second} {
+		parsed, err := parseTimeSpan(formatTimeSpan(duration))
+		require.NoError(t, err)
+		require.Equal(t, duration, parsed)
+	}
+
+	parsed, err := parseTimeSpan("00:00:01.5000000")
+	require.NoError(t, err)
+	require.Equal(t, 1500*time.Millisecond, parsed)
+
+	_, err = parseTimeSpan("10")
+	require.Error(t, err)
+	_, err = parseTimeSpan("x.01:00:00")
+	require.Error(t, err)
+}
+
+func TestSuppressEquivalentDurationDiffs(t *testing.T) {
+	require.True(t, suppressEquivalentDurationDiffs("", "1h0m0s", "60m", nil))
+	require.False(t, suppressEquivalentDurationDiffs("", "1h0m0s", "30m", nil))
+	require.False(t, suppressEquivalentDurationDiffs("", "", "30m", nil))
+}
+
+func TestExpandAndSetSubscription(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, getSubscriptionSchema(), map[string]interface{}{
+		"email_digest": []interface{}{map[string]interface{}{
+			"frequency": "30m",
+			"team_ids":  []interface{}{"Teams-1"},
+		}},
+		"event_categories": []interface{}{"LoginFailed"},
+		"event_groups":     []interface{}{"User"},
+		"name":             "Audit events",
+		"webhook": []interface{}{map[string]interface{}{
+			"header": []interface{}{map[string]interface{}{
+				"key":   "Authorization",
+				"value": "Bearer secret",
+			}},
+			"timeout": 30,
+			"url":     "https://siem.example.com/octopus",
+		}},
+	})
+
+	subscription := expandSubscription(d)
+	require.Equal(t, "Audit events", subscription.Name)
+	require.Equal(t, subscriptions.SubscriptionTypeEvent, subscription.Type)
+
+	notification := subscription.EventNotificationSubscription
+	require.Equal(t, []string{"LoginFailed"}, notification.Filter.EventCategories)
+	require.Equal(t, []string{"User"}, notification.Filter.EventGroups)
+	require.Equal(t, []string{}, notification.Filter.Projects)
+	require.Equal(t, "00:30:00", notification.EmailFrequencyPeriod)
+	require.Equal(t, subscriptions.EmailPriorityNormal, notification.EmailPriority)
+	require.Equal(t, []string{"Teams-1"}, notification.EmailTeams)
+	require.Equal(t, "UTC", notification.EmailShowDatesInTimeZoneID)
+	require.Equal(t, "Authorization", notification.WebhookHeaderKey)
+	require.Equal(t, "Bearer secret", notification.WebhookHeaderValue)
+	require.Equal(t, "00:00:30", notification.WebhookTimeout)
+	require.Equal(t, "https://siem.example.com/octopus", notification.WebhookURI)
+
+	// the server does not return the value of the webhook header
+	subscription.ID = "Subscriptions-1"
+	subscription.EventNotificationSubscription.WebhookHeaderValue = ""
+	require.NoError(t, setSubscription(context.Background(), d, subscription))
+
+	require.Equal(t, "Subscriptions-1", d.Id())
+	require.Equal(t, "30m0s", d.Get("email_digest.0.frequency"))
+	require.Equal(t, "Bearer secret", d.Get("webhook.0.header.0.value"))
+	require.Equal(t, 30, d.Get("webhook.0.timeout"))
+
+	subscription.EventNotificationSubscription.EmailTeams = []string{}
+	subscription.EventNotificationSubscription.WebhookURI = ""
+	require.NoError(t, setSubscription(context.Background(), d, subscription))
+	require.Empty(t, d.Get("email_digest"))
+	require.Empty(t, d.Get("webhook"))
+}
@@ -71,6 +71,7 @@ func getTagSetDataSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"ids":          getQueryIDs(),
 		"partial_name": getQueryPartialName(),
+		"all_pages":    getQueryAllPages(),
 		"skip":         getQuerySkip(),
 		"tag_sets": {
 			Computed:    true,
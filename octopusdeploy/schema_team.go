@@ -71,6 +71,7 @@ func flattenTeam(team *teams.Team) map[string]interface{} {
 func getTeamDataSchema() map[string]*schema.Schema {
 	dataSchema := getTeamSchema()
 	setDataSchema(&dataSchema)
+	delete(dataSchema, "ignore_unmanaged_membership")
 	delete(dataSchema, "user_role")
 
 	return map[string]*schema.Schema{
@@ -78,6 +79,7 @@ func getTeamDataSchema() map[string]*schema.Schema {
 		"ids":            getQueryIDs(),
 		"include_system": getQueryIncludeSystem(),
 		"partial_name":   getQueryPartialName(),
+		"all_pages":      getQueryAllPages(),
 		"skip":           getQuerySkip(),
 		"spaces":         getQuerySpaces(),
 		"take":           getQueryTake(),
@@ -177,6 +179,12 @@ func getTeamSchema() map[string]*schema.Schema {
 			Type:     schema.TypeList,
 		},
 		"id": getIDSchema(),
+		"ignore_unmanaged_membership": {
+			Default:     false,
+			Description: "Indicates whether members and external security groups that are not listed in `users` and `external_security_group` are left in place. Set this when membership is also managed by `octopusdeploy_team_membership` or `octopusdeploy_team_external_group`, or outside of Terraform.",
+			Optional:    true,
+			Type:        schema.TypeBool,
+		},
 		"name": {
 			Description: "The name of this team.",
 			Required:    true,
@@ -214,7 +222,14 @@ func setTeam(ctx context.Context, d *schema.ResourceData, team *teams.Team) erro
 	d.Set("can_change_roles", team.CanChangeRoles)
 	d.Set("description", team.Description)
 
-	if err := d.Set("external_security_group", flattenExternalSecurityGroups(team.ExternalSecurityGroups)); err != nil {
+	externalSecurityGroups := team.ExternalSecurityGroups
+	members := team.MemberUserIDs
+	if d.Get("ignore_unmanaged_membership").(bool) {
+		externalSecurityGroups = getManagedTeamExternalSecurityGroups(externalSecurityGroups, d.Get("external_security_group").([]interface{}))
+		members = getManagedTeamMembers(members, getSliceFromTerraformTypeList(d.Get("users")))
+	}
+
+	if err := d.Set("external_security_group", flattenExternalSecurityGroups(externalSecurityGroups)); err != nil {
 		return fmt.Errorf("error setting external_security_group: %s", err)
 	}
 
@@ -222,7 +237,7 @@ func setTeam(ctx context.Context, d *schema.ResourceData, team *teams.Team) erro
 	d.Set("name", team.Name)
 	d.Set("space_id", team.SpaceID)
 
-	if err := d.Set("users", team.MemberUserIDs); err != nil {
+	if err := d.Set("users", members); err != nil {
 		return fmt.Errorf("error setting users: %s", err)
 	}
 
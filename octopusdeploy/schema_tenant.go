@@ -65,6 +65,7 @@ func getTenantDataSchema() map[string]*schema.Schema {
 		"name":                  getQueryName(),
 		"partial_name":          getQueryPartialName(),
 		"project_id":            getQueryProjectID(),
+		"all_pages":             getQueryAllPages(),
 		"skip":                  getQuerySkip(),
 		"tags":                  getQueryTags(),
 		"tenants": {
@@ -112,13 +113,32 @@ func getTenantSchema() map[string]*schema.Schema {
 	}
 }
 
+// getTenantResourceSchema returns the schema of the tenant resource, which has arguments that the tenant data sources
+// do not.
+func getTenantResourceSchema() map[string]*schema.Schema {
+	tenantSchema := getTenantSchema()
+	tenantSchema["ignore_unmanaged_project_environments"] = &schema.Schema{
+		Default:     false,
+		Description: "Whether this resource only manages the project connections in its `project_environment` blocks, leaving the other connections of the tenant (i.e. the ones managed by `octopusdeploy_tenant_project`) as they are. Defaults to `false`, which removes the connections that are not configured.",
+		Optional:    true,
+		Type:        schema.TypeBool,
+	}
+
+	return addTenantTagSchema(tenantSchema)
+}
+
 func setTenant(ctx context.Context, d *schema.ResourceData, tenant *tenants.Tenant) error {
 	d.Set("cloned_from_tenant_id", tenant.ClonedFromTenantID)
 	d.Set("description", tenant.Description)
 	d.Set("id", tenant.GetID())
 	d.Set("name", tenant.Name)
 
-	if err := d.Set("project_environment", flattenProjectEnvironments(tenant.ProjectEnvironments)); err != nil {
+	projectEnvironments := tenant.ProjectEnvironments
+	if d.Get("ignore_unmanaged_project_environments").(bool) {
+		projectEnvironments = filterProjectEnvironments(projectEnvironments, getProjectEnvironmentProjectIDs(d.Get("project_environment")))
+	}
+
+	if err := d.Set("project_environment", flattenProjectEnvironments(projectEnvironments)); err != nil {
 		return fmt.Errorf("error setting project_environment: %s", err)
 	}
 
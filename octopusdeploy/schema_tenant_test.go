@@ -1,11 +1,13 @@
 package octopusdeploy
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
 	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tenants"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/require"
 )
 
@@ -39,3 +41,44 @@ func TestFlattenTenant(t *testing.T) {
 	actualFlattened := flattenTenant(expectedExpanded)
 	require.True(t, reflect.DeepEqual(expectedFlattened, actualFlattened))
 }
+
+func TestMergeProjectEnvironments(t *testing.T) {
+	existingProjectEnvironments := map[string][]string{
+		"Projects-1": {"Environments-1"},
+		"Projects-2": {"Environments-2"},
+		"Projects-3": {"Environments-3"},
+	}
+	managedProjectEnvironments := map[string][]string{
+		"Projects-1": {"Environments-1", "Environments-2"},
+		"Projects-4": {"Environments-4"},
+	}
+
+	// Projects-2 was removed from the configuration and Projects-3 was connected by another resource
+	actualProjectEnvironments := mergeProjectEnvironments(existingProjectEnvironments, managedProjectEnvironments, []string{"Projects-1", "Projects-2"})
+	require.Equal(t, map[string][]string{
+		"Projects-1": {"Environments-1", "Environments-2"},
+		"Projects-3": {"Environments-3"},
+		"Projects-4": {"Environments-4"},
+	}, actualProjectEnvironments)
+	require.Len(t, existingProjectEnvironments, 3)
+}
+
+func TestSetTenantIgnoresUnmanagedProjectEnvironments(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, getTenantResourceSchema(), map[string]interface{}{
+		"ignore_unmanaged_project_environments": true,
+		"name":                                  "Tenant",
+		"project_environment": []interface{}{
+			map[string]interface{}{"environments": []interface{}{"Environments-1"}, "project_id": "Projects-1"},
+		},
+	})
+
+	tenant := tenants.NewTenant("Tenant")
+	tenant.ID = "Tenants-1"
+	tenant.ProjectEnvironments = map[string][]string{
+		"Projects-1": {"Environments-1", "Environments-2"},
+		"Projects-2": {"Environments-1"},
+	}
+
+	require.NoError(t, setTenant(context.Background(), d, tenant))
+	require.Equal(t, map[string][]string{"Projects-1": {"Environments-1", "Environments-2"}}, expandProjectEnvironments(d.Get("project_environment")))
+}
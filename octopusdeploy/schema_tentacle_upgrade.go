@@ -0,0 +1,168 @@
+package octopusdeploy
+
+import (
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/machines"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func flattenTentacleUpgradeMachine(deploymentTarget *machines.DeploymentTarget) map[string]interface{} {
+	flattenedMachine := map[string]interface{}{
+		"health_status":     deploymentTarget.HealthStatus,
+		"id":                deploymentTarget.GetID(),
+		"name":              deploymentTarget.Name,
+		"tentacle_version":  "",
+		"upgrade_locked":    false,
+		"upgrade_required":  false,
+		"upgrade_suggested": false,
+	}
+
+	if deploymentTarget.Endpoint == nil {
+		return flattenedMachine
+	}
+
+	endpointResource, err := machines.ToEndpointResource(deploymentTarget.Endpoint)
+	if err != nil || endpointResource.TentacleVersionDetails == nil {
+		return flattenedMachine
+	}
+
+	flattenedMachine["tentacle_version"] = endpointResource.TentacleVersionDetails.Version
+	flattenedMachine["upgrade_locked"] = endpointResource.TentacleVersionDetails.UpgradeLocked
+	flattenedMachine["upgrade_required"] = endpointResource.TentacleVersionDetails.UpgradeRequired
+	flattenedMachine["upgrade_suggested"] = endpointResource.TentacleVersionDetails.UpgradeSuggested
+
+	return flattenedMachine
+}
+
+func getTentacleUpgradeMachineSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"health_status": {
+			Computed:    true,
+			Description: "The health status of the deployment target.",
+			Type:        schema.TypeString,
+		},
+		"id": {
+			Computed:    true,
+			Description: "The ID of the deployment target.",
+			Type:        schema.TypeString,
+		},
+		"name": {
+			Computed:    true,
+			Description: "The name of the deployment target.",
+			Type:        schema.TypeString,
+		},
+		"tentacle_version": {
+			Computed:    true,
+			Description: "The version of the tentacle that is installed on the deployment target. Empty for deployment targets that do not run a tentacle.",
+			Type:        schema.TypeString,
+		},
+		"upgrade_locked": {
+			Computed:    true,
+			Description: "Indicates whether the tentacle is locked to its version, in which case it is not upgraded.",
+			Type:        schema.TypeBool,
+		},
+		"upgrade_required": {
+			Computed:    true,
+			Description: "Indicates whether the tentacle must be upgraded to communicate with the server.",
+			Type:        schema.TypeBool,
+		},
+		"upgrade_suggested": {
+			Computed:    true,
+			Description: "Indicates whether the server bundles a newer version of the tentacle than the installed one.",
+			Type:        schema.TypeBool,
+		},
+	}
+}
+
+func getTentacleUpgradeSchema() map[string]*schema.Schema {
+	targetKeys := []string{"environments", "machine_ids", "roles"}
+
+	return map[string]*schema.Schema{
+		"calamari_task_id": {
+			Computed:    true,
+			Description: "The ID of the task that updated Calamari, when `update_calamari` is set.",
+			Type:        schema.TypeString,
+		},
+		"environments": {
+			AtLeastOneOf: targetKeys,
+			Description:  "The IDs of the environments whose deployment targets are upgraded. When `roles` are also specified, only the deployment targets of these environments that have one of the roles are upgraded.",
+			Elem:         &schema.Schema{Type: schema.TypeString},
+			ForceNew:     true,
+			Optional:     true,
+			Type:         schema.TypeList,
+		},
+		"machine_ids": {
+			AtLeastOneOf: targetKeys,
+			Description:  "The IDs of the deployment targets to upgrade, in addition to the deployment targets that match `environments` and `roles`.",
+			Elem:         &schema.Schema{Type: schema.TypeString},
+			ForceNew:     true,
+			Optional:     true,
+			Type:         schema.TypeList,
+		},
+		"machines": {
+			Computed:    true,
+			Description: "The results of the upgrade per deployment target. When the tentacle of one of these deployment targets is older than the one that is bundled with the server (and it is not locked to its version), the upgrade is planned again.",
+			Elem:        &schema.Resource{Schema: getTentacleUpgradeMachineSchema()},
+			Type:        schema.TypeList,
+		},
+		"roles": {
+			AtLeastOneOf: targetKeys,
+			Description:  "The roles of the deployment targets to upgrade. When `environments` are also specified, only the deployment targets of these environments that have one of the roles are upgraded.",
+			Elem:         &schema.Schema{Type: schema.TypeString},
+			ForceNew:     true,
+			Optional:     true,
+			Type:         schema.TypeList,
+		},
+		"space_id": getSpaceIDSchema(),
+		"task_id": {
+			Computed:    true,
+			Description: "The ID of the task that upgraded the tentacles.",
+			Type:        schema.TypeString,
+		},
+		"task_state": {
+			Computed:    true,
+			Description: "The state of the task that upgraded the tentacles.",
+			Type:        schema.TypeString,
+		},
+		"timeout": {
+			Default:          "30m",
+			Description:      "How long to wait for the upgrade (and the Calamari update) to complete (i.e. `30m` or `2h`). Defaults to `30m`.",
+			Optional:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validateDuration),
+		},
+		"update_calamari": {
+			Default:     false,
+			Description: "Indicates whether Calamari is also updated on the deployment targets once their tentacles are upgraded.",
+			ForceNew:    true,
+			Optional:    true,
+			Type:        schema.TypeBool,
+		},
+	}
+}
+
+// isTentacleUpgradePending reports whether the tentacle of one of the upgraded deployment targets is older than the one
+// that is bundled with the server and can be upgraded.
+func isTentacleUpgradePending(flattenedMachines []interface{}) bool {
+	for _, v := range flattenedMachines {
+		flattenedMachine, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if flattenedMachine["upgrade_suggested"].(bool) && !flattenedMachine["upgrade_locked"].(bool) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func setTentacleUpgradeMachines(d *schema.ResourceData, deploymentTargets []*machines.DeploymentTarget) error {
+	flattenedMachines := []interface{}{}
+	for _, deploymentTarget := range deploymentTargets {
+		flattenedMachines = append(flattenedMachines, flattenTentacleUpgradeMachine(deploymentTarget))
+	}
+
+	return d.Set("machines", flattenedMachines)
+}
@@ -0,0 +1,74 @@
+package octopusdeploy
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/machines"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenTentacleUpgradeMachine(t *testing.T) {
+	tentacleURL, _ := url.Parse("https://example.com:10933")
+	endpoint := machines.NewListeningTentacleEndpoint(tentacleURL, "thumbprint")
+	endpoint.TentacleVersionDetails = machines.NewTentacleVersionDetails("6.3.417", false, true, false)
+
+	deploymentTarget := machines.NewDeploymentTarget("web", endpoint, []string{"Environments-1"}, []string{"web"})
+	deploymentTarget.ID = "Machines-1"
+	deploymentTarget.HealthStatus = "Healthy"
+
+	flattenedMachine := flattenTentacleUpgradeMachine(deploymentTarget)
+	require.Equal(t, "Machines-1", flattenedMachine["id"])
+	require.Equal(t, "6.3.417", flattenedMachine["tentacle_version"])
+	require.Equal(t, true, flattenedMachine["upgrade_suggested"])
+
+	sshTarget := machines.NewDeploymentTarget("ssh", machines.NewSSHEndpoint("ssh.example.com", 22, "fingerprint"), []string{"Environments-1"}, []string{"web"})
+	flattenedMachine = flattenTentacleUpgradeMachine(sshTarget)
+	require.Equal(t, "", flattenedMachine["tentacle_version"])
+	require.Equal(t, false, flattenedMachine["upgrade_suggested"])
+}
+
+func TestIsTentacleUpgradePending(t *testing.T) {
+	upToDate := map[string]interface{}{"upgrade_locked": false, "upgrade_suggested": false}
+	outdated := map[string]interface{}{"upgrade_locked": false, "upgrade_suggested": true}
+	locked := map[string]interface{}{"upgrade_locked": true, "upgrade_suggested": true}
+
+	require.False(t, isTentacleUpgradePending([]interface{}{}))
+	require.False(t, isTentacleUpgradePending([]interface{}{upToDate, locked}))
+	require.True(t, isTentacleUpgradePending([]interface{}{upToDate, outdated}))
+}
+
+func TestTentacleUpgradeDiff(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "ServerTasks-1",
+		Attributes: map[string]string{
+			"id":                           "ServerTasks-1",
+			"machine_ids.#":                "1",
+			"machine_ids.0":                "Machines-1",
+			"machines.#":                   "1",
+			"machines.0.id":                "Machines-1",
+			"machines.0.upgrade_locked":    "false",
+			"machines.0.upgrade_suggested": "false",
+			"task_id":                      "ServerTasks-1",
+			"timeout":                      "30m",
+			"update_calamari":              "false",
+		},
+	}
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"machine_ids": []interface{}{"Machines-1"},
+	})
+
+	diff, err := resourceTentacleUpgrade().Diff(context.Background(), state, config, nil)
+	require.NoError(t, err)
+	require.True(t, diff == nil || !diff.RequiresNew())
+
+	state.Attributes["machines.0.upgrade_suggested"] = "true"
+
+	diff, err = resourceTentacleUpgrade().Diff(context.Background(), state, config, nil)
+	require.NoError(t, err)
+	require.NotNil(t, diff)
+	require.True(t, diff.RequiresNew())
+}
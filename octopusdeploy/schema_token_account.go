@@ -11,7 +11,7 @@ import (
 
 func expandTokenAccount(d *schema.ResourceData) *accounts.TokenAccount {
 	name := d.Get("name").(string)
-	token := core.NewSensitiveValue(d.Get("token").(string))
+	token := expandAccountSecret(d, "token")
 
 	account, _ := accounts.NewTokenAccount(name, token)
 	account.ID = d.Id()
@@ -44,7 +44,7 @@ func expandTokenAccount(d *schema.ResourceData) *accounts.TokenAccount {
 }
 
 func getTokenAccountSchema() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
+	return addAccountCredentialSchema(map[string]*schema.Schema{
 		"description":                       getDescriptionSchema("token account"),
 		"environments":                      getEnvironmentsSchema(),
 		"id":                                getIDSchema(),
@@ -54,7 +54,7 @@ func getTokenAccountSchema() map[string]*schema.Schema {
 		"tenants":                           getTenantsSchema(),
 		"tenant_tags":                       getTenantTagsSchema(),
 		"token":                             getTokenSchema(true),
-	}
+	}, "token")
 }
 
 func setTokenAccount(ctx context.Context, d *schema.ResourceData, account *accounts.TokenAccount) error {
@@ -76,5 +76,9 @@ func setTokenAccount(ctx context.Context, d *schema.ResourceData, account *accou
 		return fmt.Errorf("error setting tenant_tags: %s", err)
 	}
 
+	if err := setAccountModification(d, account); err != nil {
+		return err
+	}
+
 	return nil
 }
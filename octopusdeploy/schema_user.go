@@ -64,12 +64,13 @@ func getUserDataSchema() map[string]*schema.Schema {
 	setDataSchema(&dataSchema)
 
 	return map[string]*schema.Schema{
-		"filter":   getQueryFilter(),
-		"id":       getDataSchemaID(),
-		"ids":      getQueryIDs(),
-		"skip":     getQuerySkip(),
-		"take":     getQueryTake(),
-		"space_id": getQuerySpaceID(),
+		"filter":    getQueryFilter(),
+		"id":        getDataSchemaID(),
+		"ids":       getQueryIDs(),
+		"all_pages": getQueryAllPages(),
+		"skip":      getQuerySkip(),
+		"take":      getQueryTake(),
+		"space_id":  getQuerySpaceID(),
 		"users": {
 			Computed:    true,
 			Description: "A list of users that match the filter(s).",
@@ -0,0 +1,133 @@
+package octopusdeploy
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/users"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func expandUserAPIKey(d *schema.ResourceData) *users.APIKey {
+	apiKey := users.NewAPIKey(d.Get("purpose").(string), d.Get("user_id").(string))
+	apiKey.ID = d.Id()
+
+	// the expiry is relative to the creation of the API key, so that a replacement expires later than the API key
+	// that it replaces
+	if v, ok := d.GetOk("expires_in_days"); ok {
+		expires := time.Now().UTC().AddDate(0, 0, v.(int))
+		apiKey.Expires = &expires
+	}
+
+	return apiKey
+}
+
+func getUserAPIKeySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"api_key": {
+			Computed:    true,
+			Description: "The API key. It is only available when the API key is created and is not populated for imported API keys.",
+			Sensitive:   true,
+			Type:        schema.TypeString,
+		},
+		"created": {
+			Computed:    true,
+			Description: "The time at which the API key was created, in RFC 3339 format.",
+			Type:        schema.TypeString,
+		},
+		"expires_at": {
+			Computed:    true,
+			Description: "The time at which the API key expires, in RFC 3339 format. API keys without an expiry never expire.",
+			Type:        schema.TypeString,
+		},
+		"expires_in_days": {
+			Computed:         true,
+			Description:      "The number of days after its creation at which the API key expires. API keys without an expiry never expire. It is derived from `created` and `expires_at` for imported API keys, which are not replaced when it differs from their lifetime by less than a day.",
+			DiffSuppressFunc: suppressUserAPIKeyLifetimeDiffs,
+			ForceNew:         true,
+			Optional:         true,
+			Type:             schema.TypeInt,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+		},
+		"purpose": {
+			Description:      "The purpose of the API key.",
+			ForceNew:         true,
+			Required:         true,
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+		},
+		"rotation_window_days": {
+			Default:          0,
+			Description:      "The number of days before `expires_at` within which the API key is replaced by a new one. The default of `0` disables rotation. The new API key expires `expires_in_days` after it is created.",
+			Optional:         true,
+			Type:             schema.TypeInt,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+		},
+		"user_id": {
+			Description: "The ID of the user or service account that the API key belongs to.",
+			ForceNew:    true,
+			Required:    true,
+			Type:        schema.TypeString,
+		},
+	}
+}
+
+// isAPIKeyDueForRotation returns true if an API key expires within the rotation window.
+func isAPIKeyDueForRotation(expiresAt string, rotationWindowDays int, now time.Time) bool {
+	if len(expiresAt) == 0 || rotationWindowDays <= 0 {
+		return false
+	}
+
+	expires, err := time.Parse(time.RFC3339, expiresAt)
+	if err != nil {
+		return false
+	}
+
+	return now.AddDate(0, 0, rotationWindowDays).After(expires)
+}
+
+// suppressUserAPIKeyLifetimeDiffs suppresses differences between expires_in_days and the lifetime of an existing API
+// key of less than a day, since the lifetime of an imported API key is not necessarily a whole number of days.
+func suppressUserAPIKeyLifetimeDiffs(k, old, new string, d *schema.ResourceData) bool {
+	days, err := strconv.Atoi(new)
+	if err != nil {
+		return false
+	}
+
+	created, err := time.Parse(time.RFC3339, d.Get("created").(string))
+	if err != nil {
+		return false
+	}
+
+	expires, err := time.Parse(time.RFC3339, d.Get("expires_at").(string))
+	if err != nil {
+		return false
+	}
+
+	return math.Abs(expires.Sub(created).Hours()/24-float64(days)) < 1
+}
+
+func setUserAPIKey(ctx context.Context, d *schema.ResourceData, apiKey *users.APIKey) {
+	if apiKey.Created != nil {
+		d.Set("created", apiKey.Created.Format(time.RFC3339))
+	}
+
+	if apiKey.Expires != nil {
+		d.Set("expires_at", apiKey.Expires.Format(time.RFC3339))
+	} else {
+		d.Set("expires_at", nil)
+	}
+
+	// imported API keys have no expires_in_days, so it is derived from their lifetime
+	if _, ok := d.GetOk("expires_in_days"); !ok && apiKey.Created != nil && apiKey.Expires != nil {
+		d.Set("expires_in_days", int(math.Round(apiKey.Expires.Sub(*apiKey.Created).Hours()/24)))
+	}
+
+	d.Set("purpose", apiKey.Purpose)
+	d.Set("user_id", apiKey.UserID)
+
+	d.SetId(apiKey.GetID())
+}
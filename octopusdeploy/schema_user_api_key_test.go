@@ -0,0 +1,130 @@
+package octopusdeploy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/users"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsAPIKeyDueForRotation(t *testing.T) {
+	now := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	require.True(t, isAPIKeyDueForRotation("2023-06-10T00:00:00Z", 30, now))
+	require.True(t, isAPIKeyDueForRotation("2023-05-01T00:00:00Z", 1, now))
+	require.False(t, isAPIKeyDueForRotation("2023-09-01T00:00:00Z", 30, now))
+	require.False(t, isAPIKeyDueForRotation("2023-06-10T00:00:00Z", 0, now))
+	require.False(t, isAPIKeyDueForRotation("", 30, now))
+	require.False(t, isAPIKeyDueForRotation("not a time", 30, now))
+}
+
+func TestUserAPIKeyRotationDiff(t *testing.T) {
+	expiresAt := time.Now().UTC().AddDate(0, 0, 10).Format(time.RFC3339)
+
+	state := &terraform.InstanceState{
+		ID: "APIKeys-1",
+		Attributes: map[string]string{
+			"api_key":              "API-XXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+			"expires_at":           expiresAt,
+			"expires_in_days":      "90",
+			"id":                   "APIKeys-1",
+			"purpose":              "Deployments",
+			"rotation_window_days": "30",
+			"user_id":              "Users-1",
+		},
+	}
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"expires_in_days":      90,
+		"purpose":              "Deployments",
+		"rotation_window_days": 30,
+		"user_id":              "Users-1",
+	})
+
+	diff, err := resourceUserAPIKey().Diff(context.Background(), state, config, nil)
+	require.NoError(t, err)
+	require.NotNil(t, diff)
+	require.True(t, diff.RequiresNew())
+	require.True(t, diff.Attributes["expires_at"].NewComputed)
+
+	config = terraform.NewResourceConfigRaw(map[string]interface{}{
+		"expires_in_days":      90,
+		"purpose":              "Deployments",
+		"rotation_window_days": 5,
+		"user_id":              "Users-1",
+	})
+	state.Attributes["rotation_window_days"] = "5"
+
+	diff, err = resourceUserAPIKey().Diff(context.Background(), state, config, nil)
+	require.NoError(t, err)
+	require.True(t, diff == nil || !diff.RequiresNew())
+}
+
+func TestExpandUserAPIKeyExpiresInDays(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, getUserAPIKeySchema(), map[string]interface{}{
+		"expires_in_days": 90,
+		"purpose":         "Deployments",
+		"user_id":         "Users-1",
+	})
+
+	before := time.Now().UTC().AddDate(0, 0, 90)
+	apiKey := expandUserAPIKey(d)
+	after := time.Now().UTC().AddDate(0, 0, 90)
+
+	require.NotNil(t, apiKey.Expires)
+	require.False(t, apiKey.Expires.Before(before))
+	require.False(t, apiKey.Expires.After(after))
+}
+
+func TestSetUserAPIKeyDerivesExpiresInDays(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, getUserAPIKeySchema(), map[string]interface{}{})
+
+	created := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	expires := created.AddDate(0, 0, 30)
+	apiKey := users.NewAPIKey("Deployments", "Users-1")
+	apiKey.Created = &created
+	apiKey.Expires = &expires
+	apiKey.ID = "APIKeys-1"
+
+	setUserAPIKey(context.Background(), d, apiKey)
+	require.Equal(t, 30, d.Get("expires_in_days"))
+	require.Equal(t, "2023-07-01T00:00:00Z", d.Get("expires_at"))
+}
+
+func TestImportedUserAPIKeyLifetimeDiff(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "APIKeys-1",
+		Attributes: map[string]string{
+			"created":              "2023-06-01T00:00:00Z",
+			"expires_at":           "2023-08-29T14:00:00Z",
+			"expires_in_days":      "90",
+			"id":                   "APIKeys-1",
+			"purpose":              "Deployments",
+			"rotation_window_days": "0",
+			"user_id":              "Users-1",
+		},
+	}
+
+	config := map[string]interface{}{
+		"purpose": "Deployments",
+		"user_id": "Users-1",
+	}
+
+	diff, err := resourceUserAPIKey().Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+	require.NoError(t, err)
+	require.True(t, diff == nil || !diff.RequiresNew())
+
+	config["expires_in_days"] = 89
+	diff, err = resourceUserAPIKey().Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+	require.NoError(t, err)
+	require.True(t, diff == nil || !diff.RequiresNew())
+
+	config["expires_in_days"] = 120
+	diff, err = resourceUserAPIKey().Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+	require.NoError(t, err)
+	require.True(t, diff.RequiresNew())
+}
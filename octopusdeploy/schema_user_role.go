@@ -77,6 +77,7 @@ func getUserRoleDataSchema() map[string]*schema.Schema {
 		"id":           getDataSchemaID(),
 		"ids":          getQueryIDs(),
 		"partial_name": getQueryPartialName(),
+		"all_pages":    getQueryAllPages(),
 		"skip":         getQuerySkip(),
 		"take":         getQueryTake(),
 		"space_id":     getQuerySpaceID(),
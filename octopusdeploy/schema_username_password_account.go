@@ -14,7 +14,7 @@ func expandUsernamePasswordAccount(d *schema.ResourceData) accounts.IUsernamePas
 
 	account, _ := accounts.NewUsernamePasswordAccount(name)
 	account.SetID(d.Id())
-	account.SetPassword(core.NewSensitiveValue(d.Get("password").(string)))
+	account.SetPassword(expandAccountSecret(d, "password"))
 
 	if v, ok := d.GetOk("description"); ok {
 		account.SetDescription(v.(string))
@@ -71,11 +71,15 @@ func setUsernamePasswordAccount(ctx context.Context, d *schema.ResourceData, acc
 
 	d.SetId(account.GetID())
 
+	if err := setAccountModification(d, account); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 func getUsernamePasswordAccountSchema() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
+	return addAccountCredentialSchema(map[string]*schema.Schema{
 		"description":                       getDescriptionSchema("username/password account"),
 		"environments":                      getEnvironmentsSchema(),
 		"id":                                getIDSchema(),
@@ -86,5 +90,5 @@ func getUsernamePasswordAccountSchema() map[string]*schema.Schema {
 		"tenants":                           getTenantsSchema(),
 		"tenant_tags":                       getTenantTagsSchema(),
 		"username":                          getUsernameSchema(true),
-	}
+	}, "password")
 }
@@ -3,6 +3,7 @@ package octopusdeploy
 import (
 	"fmt"
 
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -451,3 +452,13 @@ func setDataSchema(schema *map[string]*schema.Schema) {
 		field.ValidateDiagFunc = nil
 	}
 }
+
+// expandChangedSensitiveValue returns the sensitive value to send to the server, or nil if it has not changed. The
+// server never returns sensitive values, so they are only sent when their configured value changes.
+func expandChangedSensitiveValue(d *schema.ResourceData, key string) *core.SensitiveValue {
+	if !d.HasChange(key) {
+		return nil
+	}
+
+	return core.NewSensitiveValue(d.Get(key).(string))
+}
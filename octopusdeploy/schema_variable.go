@@ -161,7 +161,7 @@ func getVariableDataSchema() map[string]*schema.Schema {
 }
 
 func getVariableSchema() map[string]*schema.Schema {
-	return map[string]*schema.Schema{
+	variableSchema := map[string]*schema.Schema{
 		"description": getDescriptionSchema("variable"),
 		"encrypted_value": {
 			Computed: true,
@@ -273,6 +273,10 @@ func getVariableSchema() map[string]*schema.Schema {
 			Type:          schema.TypeString,
 		},
 	}
+
+	addGitRefSchema(variableSchema, false)
+
+	return variableSchema
 }
 
 func setVariable(ctx context.Context, d *schema.ResourceData, variable *variables.Variable) error {
@@ -0,0 +1,223 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/variables"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func expandVariableSetVariable(flattenedVariable map[string]interface{}) (*variables.Variable, error) {
+	name := flattenedVariable["name"].(string)
+	isSensitive := flattenedVariable["is_sensitive"].(bool)
+	variableType := flattenedVariable["type"].(string)
+
+	if err := validateVariableSensitivity(isSensitive, variableType); err != nil {
+		return nil, fmt.Errorf("variable '%s': %s", name, err)
+	}
+
+	variable := variables.NewVariable(name)
+	variable.Description = flattenedVariable["description"].(string)
+	variable.IsEditable = flattenedVariable["is_editable"].(bool)
+	variable.IsSensitive = isSensitive
+	variable.Prompt = expandPromptedVariableSettings(flattenedVariable["prompt"])
+	variable.Scope = expandVariableScope(flattenedVariable["scope"])
+	variable.Type = variableType
+
+	if isSensitive {
+		variable.Value = flattenedVariable["sensitive_value"].(string)
+	} else {
+		variable.Value = flattenedVariable["value"].(string)
+	}
+
+	return variable, nil
+}
+
+func expandVariableSetVariables(flattenedVariables []interface{}) ([]*variables.Variable, error) {
+	expandedVariables := []*variables.Variable{}
+	for _, flattenedVariable := range flattenedVariables {
+		variable, err := expandVariableSetVariable(flattenedVariable.(map[string]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		expandedVariables = append(expandedVariables, variable)
+	}
+
+	return expandedVariables, nil
+}
+
+// flattenVariableSetVariables converts the variables of a variable set into their HCL representation. Variables
+// are ordered as they were in the existing state so that the server's ordering does not produce a diff, and
+// sensitive values (which the server never returns) are carried over from the existing state.
+func flattenVariableSetVariables(existingVariables []*variables.Variable, stateVariables []interface{}) []interface{} {
+	// state entries are matched by ID and, for variables that have not been assigned an ID yet, by name and scope
+	statePositions := map[string]int{}
+	stateSensitiveValues := map[string]interface{}{}
+	for i, stateVariable := range stateVariables {
+		flattenedStateVariable, ok := stateVariable.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		stateKey := getVariableKey(&variables.Variable{
+			Name:  flattenedStateVariable["name"].(string),
+			Scope: expandVariableScope(flattenedStateVariable["scope"]),
+		})
+		keys := []string{stateKey}
+		if id, _ := flattenedStateVariable["id"].(string); len(id) > 0 {
+			keys = append(keys, id)
+		}
+
+		for _, key := range keys {
+			if _, ok := statePositions[key]; !ok {
+				statePositions[key] = i
+				stateSensitiveValues[key] = flattenedStateVariable["sensitive_value"]
+			}
+		}
+	}
+
+	getStateKey := func(variable *variables.Variable) string {
+		if _, ok := statePositions[variable.GetID()]; ok {
+			return variable.GetID()
+		}
+		return getVariableKey(variable)
+	}
+
+	orderedVariables := make([]*variables.Variable, len(existingVariables))
+	copy(orderedVariables, existingVariables)
+	sort.SliceStable(orderedVariables, func(i, j int) bool {
+		iPosition, iOk := statePositions[getStateKey(orderedVariables[i])]
+		jPosition, jOk := statePositions[getStateKey(orderedVariables[j])]
+		if iOk && jOk {
+			return iPosition < jPosition
+		}
+		return iOk && !jOk
+	})
+
+	flattenedVariables := []interface{}{}
+	for _, variable := range orderedVariables {
+		flattenedVariable := map[string]interface{}{
+			"description":  variable.Description,
+			"id":           variable.GetID(),
+			"is_editable":  variable.IsEditable,
+			"is_sensitive": variable.IsSensitive,
+			"name":         variable.Name,
+			"prompt":       flattenPromptedVariableSettings(variable.Prompt),
+			"scope":        flattenVariableScope(variable.Scope),
+			"type":         variable.Type,
+		}
+
+		if variable.IsSensitive {
+			flattenedVariable["sensitive_value"] = stateSensitiveValues[getStateKey(variable)]
+		} else {
+			flattenedVariable["value"] = variable.Value
+		}
+
+		flattenedVariables = append(flattenedVariables, flattenedVariable)
+	}
+
+	return flattenedVariables
+}
+
+// getVariableKey returns a value that identifies a variable by its name and scope, which is what distinguishes the
+// variables of a variable set from one another.
+func getVariableKey(variable *variables.Variable) string {
+	scopes := [][]string{
+		variable.Scope.Actions,
+		variable.Scope.Channels,
+		variable.Scope.Environments,
+		variable.Scope.Machines,
+		variable.Scope.Roles,
+		variable.Scope.TenantTags,
+	}
+
+	key := []string{strings.ToLower(variable.Name)}
+	for _, scope := range scopes {
+		values := append([]string{}, scope...)
+		sort.Strings(values)
+		key = append(key, strings.Join(values, ","))
+	}
+
+	return strings.Join(key, "|")
+}
+
+// setVariableSetVariableIDs assigns the IDs of existing variables to the desired variables that share their name
+// and scope, so that updated variables keep their identity (and their snapshot history) on the server.
+func setVariableSetVariableIDs(desiredVariables []*variables.Variable, existingVariables []*variables.Variable) {
+	existingIDs := map[string][]string{}
+	for _, variable := range existingVariables {
+		key := getVariableKey(variable)
+		existingIDs[key] = append(existingIDs[key], variable.GetID())
+	}
+
+	for _, variable := range desiredVariables {
+		key := getVariableKey(variable)
+		if ids := existingIDs[key]; len(ids) > 0 {
+			variable.ID = ids[0]
+			existingIDs[key] = ids[1:]
+		} else {
+			variable.ID = ""
+		}
+	}
+}
+
+func getVariableSetVariableSchema() map[string]*schema.Schema {
+	variableSchema := getVariableSchema()
+
+	elementSchema := map[string]*schema.Schema{
+		"id": {
+			Computed:    true,
+			Description: "The ID of this variable.",
+			Type:        schema.TypeString,
+		},
+	}
+	for _, key := range []string{"description", "is_editable", "is_sensitive", "name", "prompt", "scope", "sensitive_value", "type", "value"} {
+		element := *variableSchema[key]
+		element.ConflictsWith = nil
+		elementSchema[key] = &element
+	}
+
+	elementSchema["sensitive_value"].Description = "The value of this variable when it is sensitive. The server never returns sensitive values, so the value in state is retained."
+	elementSchema["value"].Description = "The value of this variable when it is not sensitive."
+
+	return elementSchema
+}
+
+func getVariableSetSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"owner_id": {
+			Description: "The ID of the project or library variable set that owns the variables.",
+			ForceNew:    true,
+			Required:    true,
+			Type:        schema.TypeString,
+		},
+		"space_id": getSpaceIDSchema(),
+		"variable": {
+			Description: "The complete list of variables of the owner. Variables that are not listed are removed.",
+			Elem:        &schema.Resource{Schema: getVariableSetVariableSchema()},
+			Optional:    true,
+			Type:        schema.TypeList,
+		},
+		"version": {
+			Computed:    true,
+			Description: "The version of the variable set, which is used to detect changes made outside of Terraform.",
+			Type:        schema.TypeInt,
+		},
+	}
+}
+
+func setVariableSet(ctx context.Context, d *schema.ResourceData, variableSet *variables.VariableSet) error {
+	stateVariables, _ := d.Get("variable").([]interface{})
+	if err := d.Set("variable", flattenVariableSetVariables(variableSet.Variables, stateVariables)); err != nil {
+		return fmt.Errorf("error setting variable: %s", err)
+	}
+
+	d.Set("owner_id", variableSet.OwnerID)
+	d.Set("space_id", variableSet.SpaceID)
+	d.Set("version", int(variableSet.Version))
+
+	return nil
+}
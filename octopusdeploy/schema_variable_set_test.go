@@ -0,0 +1,102 @@
+package octopusdeploy
+
+import (
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/variables"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestVariableSetVariable(id string, name string, environments ...string) *variables.Variable {
+	variable := variables.NewVariable(name)
+	variable.ID = id
+	variable.Scope = variables.VariableScope{Environments: environments}
+	return variable
+}
+
+func TestExpandVariableSetVariables(t *testing.T) {
+	flattenedVariables := []interface{}{
+		map[string]interface{}{
+			"description":     "",
+			"is_editable":     true,
+			"is_sensitive":    false,
+			"name":            "Greeting",
+			"prompt":          []interface{}{},
+			"scope":           []interface{}{map[string]interface{}{"environments": []interface{}{"Environments-1"}}},
+			"sensitive_value": "",
+			"type":            "String",
+			"value":           "Hello",
+		},
+		map[string]interface{}{
+			"description":     "",
+			"is_editable":     true,
+			"is_sensitive":    true,
+			"name":            "Password",
+			"prompt":          []interface{}{},
+			"scope":           []interface{}{},
+			"sensitive_value": "secret",
+			"type":            "Sensitive",
+			"value":           "",
+		},
+	}
+
+	expandedVariables, err := expandVariableSetVariables(flattenedVariables)
+	require.NoError(t, err)
+	require.Len(t, expandedVariables, 2)
+	require.Equal(t, "Hello", expandedVariables[0].Value)
+	require.Equal(t, []string{"Environments-1"}, expandedVariables[0].Scope.Environments)
+	require.Equal(t, "secret", expandedVariables[1].Value)
+
+	flattenedVariables[1].(map[string]interface{})["type"] = "String"
+	_, err = expandVariableSetVariables(flattenedVariables)
+	require.Error(t, err)
+}
+
+func TestSetVariableSetVariableIDs(t *testing.T) {
+	existingVariables := []*variables.Variable{
+		newTestVariableSetVariable("1", "Greeting", "Environments-1", "Environments-2"),
+		newTestVariableSetVariable("2", "Greeting"),
+		newTestVariableSetVariable("3", "Removed"),
+	}
+
+	desiredVariables := []*variables.Variable{
+		newTestVariableSetVariable("", "Greeting"),
+		newTestVariableSetVariable("", "greeting", "Environments-2", "Environments-1"),
+		newTestVariableSetVariable("stale", "Added"),
+	}
+
+	setVariableSetVariableIDs(desiredVariables, existingVariables)
+	require.Equal(t, "2", desiredVariables[0].ID)
+	require.Equal(t, "1", desiredVariables[1].ID)
+	require.Empty(t, desiredVariables[2].ID)
+}
+
+func TestFlattenVariableSetVariables(t *testing.T) {
+	password := newTestVariableSetVariable("2", "Password")
+	password.IsSensitive = true
+	password.Type = "Sensitive"
+
+	existingVariables := []*variables.Variable{
+		newTestVariableSetVariable("3", "Unmanaged"),
+		password,
+		newTestVariableSetVariable("1", "Greeting"),
+	}
+
+	stateVariables := []interface{}{
+		map[string]interface{}{"id": "1", "name": "Greeting", "scope": []interface{}{}, "sensitive_value": ""},
+		map[string]interface{}{"id": "", "name": "Password", "scope": []interface{}{}, "sensitive_value": "secret"},
+	}
+
+	flattenedVariables := flattenVariableSetVariables(existingVariables, stateVariables)
+	require.Len(t, flattenedVariables, 3)
+
+	names := []string{}
+	for _, flattenedVariable := range flattenedVariables {
+		names = append(names, flattenedVariable.(map[string]interface{})["name"].(string))
+	}
+	require.Equal(t, []string{"Greeting", "Password", "Unmanaged"}, names)
+
+	flattenedPassword := flattenedVariables[1].(map[string]interface{})
+	require.Equal(t, "secret", flattenedPassword["sensitive_value"])
+	require.NotContains(t, flattenedPassword, "value")
+}
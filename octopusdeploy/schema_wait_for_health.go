@@ -0,0 +1,128 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const defaultWaitForHealthTimeout = 10 * time.Minute
+
+// defaultWaitForHealthResourceTimeout is the default create and update timeout of the resources with a wait_for_health
+// block. The wait for health must finish within it.
+const defaultWaitForHealthResourceTimeout = 30 * time.Minute
+
+// waitForHealth determines how long to wait for a machine (i.e. a deployment target) to become healthy and which
+// health statuses are accepted.
+type waitForHealth struct {
+	RequiredStatuses []string
+	Timeout          time.Duration
+}
+
+// addWaitForHealthSchema adds the wait_for_health block to the schema of a deployment target resource. The block only
+// configures the resource; it is never sent to (or read from) Octopus Deploy.
+func addWaitForHealthSchema(resourceSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	resourceSchema["wait_for_health"] = getWaitForHealthSchema()
+	return resourceSchema
+}
+
+func expandWaitForHealth(v interface{}) *waitForHealth {
+	flattenedValues, ok := v.([]interface{})
+	if !ok || len(flattenedValues) == 0 || flattenedValues[0] == nil {
+		return nil
+	}
+
+	flattenedMap := flattenedValues[0].(map[string]interface{})
+
+	wait := &waitForHealth{
+		RequiredStatuses: getSliceFromTerraformTypeList(flattenedMap["required_status"]),
+		Timeout:          defaultWaitForHealthTimeout,
+	}
+
+	if len(wait.RequiredStatuses) == 0 {
+		wait.RequiredStatuses = []string{"Healthy"}
+	}
+
+	if timeout, err := time.ParseDuration(flattenedMap["timeout"].(string)); err == nil {
+		wait.Timeout = timeout
+	}
+
+	return wait
+}
+
+// getWaitForHealthTimeouts returns the create and update timeouts of a resource with a wait_for_health block.
+func getWaitForHealthTimeouts() *schema.ResourceTimeout {
+	return &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(defaultWaitForHealthResourceTimeout),
+		Update: schema.DefaultTimeout(defaultWaitForHealthResourceTimeout),
+	}
+}
+
+// validateWaitForHealthDiff ensures that the timeout of the wait_for_health block is shorter than the create (or
+// update) timeout of the resource, which would otherwise cancel the wait before it reports the health check log.
+func validateWaitForHealthDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	wait := expandWaitForHealth(d.Get("wait_for_health"))
+	if wait == nil {
+		return nil
+	}
+
+	operation := schema.TimeoutUpdate
+	if len(d.Id()) == 0 {
+		operation = schema.TimeoutCreate
+	}
+
+	resourceTimeout := defaultWaitForHealthResourceTimeout
+	if rawConfig := d.GetRawConfig(); rawConfig.IsKnown() && !rawConfig.IsNull() && rawConfig.Type().HasAttribute("timeouts") {
+		if rawTimeouts := rawConfig.GetAttr("timeouts"); rawTimeouts.IsKnown() && !rawTimeouts.IsNull() {
+			if rawTimeout := rawTimeouts.GetAttr(operation); rawTimeout.IsKnown() && !rawTimeout.IsNull() {
+				if timeout, err := time.ParseDuration(rawTimeout.AsString()); err == nil {
+					resourceTimeout = timeout
+				}
+			}
+		}
+	}
+
+	if wait.Timeout >= resourceTimeout {
+		return fmt.Errorf("the wait_for_health timeout (%s) must be shorter than the %s timeout of this resource (%s); increase it in a timeouts block", wait.Timeout, operation, resourceTimeout)
+	}
+
+	return nil
+}
+
+func getWaitForHealthSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "When set, creating or updating this resource queues a health check and waits until the machine reports one of the required health statuses. The resource fails, with the log of the last health check, if that does not happen within the timeout.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"required_status": {
+					Description: "The health statuses that are accepted. Defaults to `Healthy`. Valid statuses are `HasWarnings`, `Healthy`, `Unavailable`, `Unhealthy`, and `Unknown`.",
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+						ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+							"HasWarnings",
+							"Healthy",
+							"Unavailable",
+							"Unhealthy",
+							"Unknown",
+						}, false)),
+					},
+					Optional: true,
+					Type:     schema.TypeList,
+				},
+				"timeout": {
+					Default:          "10m",
+					Description:      "How long to wait for the machine to become healthy (i.e. `5m` or `1h`). Defaults to `10m`. It must be shorter than the create and update timeouts of the resource, which default to `30m` and can be increased in a `timeouts` block.",
+					Optional:         true,
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validation.ToDiagFunc(validateDuration),
+				},
+			},
+		},
+		MaxItems: 1,
+		Optional: true,
+		Type:     schema.TypeList,
+	}
+}
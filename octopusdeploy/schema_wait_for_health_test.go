@@ -0,0 +1,91 @@
+package octopusdeploy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/servertasks"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandWaitForHealth(t *testing.T) {
+	resourceSchema := addWaitForHealthSchema(getCloudRegionDeploymentTargetSchema())
+
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{})
+	require.Nil(t, expandWaitForHealth(d.Get("wait_for_health")))
+
+	d = schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+		"wait_for_health": []interface{}{map[string]interface{}{}},
+	})
+	wait := expandWaitForHealth(d.Get("wait_for_health"))
+	require.NotNil(t, wait)
+	require.Equal(t, []string{"Healthy"}, wait.RequiredStatuses)
+	require.Equal(t, 10*time.Minute, wait.Timeout)
+
+	d = schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+		"wait_for_health": []interface{}{map[string]interface{}{
+			"required_status": []interface{}{"Healthy", "HasWarnings"},
+			"timeout":         "90s",
+		}},
+	})
+	wait = expandWaitForHealth(d.Get("wait_for_health"))
+	require.Equal(t, []string{"Healthy", "HasWarnings"}, wait.RequiredStatuses)
+	require.Equal(t, 90*time.Second, wait.Timeout)
+}
+
+func TestWaitForHealthTimeoutDiff(t *testing.T) {
+	config := map[string]interface{}{
+		"name":            "Cloud Region",
+		"environments":    []interface{}{"Environments-1"},
+		"roles":           []interface{}{"web"},
+		"wait_for_health": []interface{}{map[string]interface{}{"timeout": "1h"}},
+	}
+
+	_, err := resourceCloudRegionDeploymentTarget().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), nil)
+	require.ErrorContains(t, err, "the wait_for_health timeout (1h0m0s) must be shorter than the create timeout of this resource (30m0s); increase it in a timeouts block")
+
+	// terraform passes the configured timeouts to the plan in the raw configuration
+	state := &terraform.InstanceState{
+		RawConfig: cty.ObjectVal(map[string]cty.Value{
+			"timeouts": cty.ObjectVal(map[string]cty.Value{
+				"create": cty.StringVal("90m"),
+				"update": cty.NullVal(cty.String),
+			}),
+		}),
+	}
+	_, err = resourceCloudRegionDeploymentTarget().Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+	require.NoError(t, err)
+
+	config["wait_for_health"] = []interface{}{map[string]interface{}{}}
+	_, err = resourceCloudRegionDeploymentTarget().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), nil)
+	require.NoError(t, err)
+}
+
+func TestHealthCheckTask(t *testing.T) {
+	task := servertasks.NewHealthCheckTask("Spaces-1", "Check health of target", []string{"Machines-1"}, 90*time.Second)
+	require.Equal(t, "Health", task.Name)
+	require.Equal(t, []string{"Machines-1"}, task.Arguments["MachineIds"])
+	require.Equal(t, "00:01:30", task.Arguments["Timeout"])
+
+	require.Equal(t, "1.02:03:04", servertasks.FormatTimeSpan(26*time.Hour+3*time.Minute+4*time.Second))
+	require.Equal(t, "00:00:00", servertasks.FormatTimeSpan(-time.Second))
+}
+
+func TestFormatActivityLogs(t *testing.T) {
+	activityLogs := []*servertasks.ActivityLog{{
+		Name: "Check deployment target health",
+		Children: []*servertasks.ActivityLog{{
+			Name: "target",
+			LogElements: []*servertasks.ActivityEntry{
+				{Category: "Info", MessageText: "Performing health check"},
+				{Category: "Error", MessageText: "The remote host closed the connection"},
+			},
+		}},
+	}}
+
+	require.Equal(t, "Info    Performing health check\nError   The remote host closed the connection", servertasks.FormatActivityLogs(activityLogs))
+}
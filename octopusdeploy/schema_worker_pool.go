@@ -32,6 +32,7 @@ func getWorkerPoolDataSchema() map[string]*schema.Schema {
 		"ids":          getQueryIDs(),
 		"name":         getQueryName(),
 		"partial_name": getQueryPartialName(),
+		"all_pages":    getQueryAllPages(),
 		"skip":         getQuerySkip(),
 		"take":         getQueryTake(),
 		"space_id":     getSpaceIDSchema(),
@@ -0,0 +1,49 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tasks"
+	"github.com/OctopusDeploy/terraform-provider-octopusdeploy/internal/servertasks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// serverTaskLogTail is the number of messages of the log of a task that are included in diagnostics.
+const serverTaskLogTail = 50
+
+// waitForServerTask waits for a task to complete, regardless of its outcome, and returns it.
+func waitForServerTask(ctx context.Context, client *client.Client, spaceID string, taskID string, timeout time.Duration) (*tasks.Task, error) {
+	var task *tasks.Task
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		var err error
+		task, err = servertasks.GetByID(client, spaceID, taskID)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if !servertasks.IsCompleted(task) {
+			log.Printf("[INFO] waiting for task (%s) to complete", taskID)
+			return resource.RetryableError(fmt.Errorf("task %s is %s", taskID, task.State))
+		}
+
+		return nil
+	})
+
+	return task, err
+}
+
+// getServerTaskLog returns the last messages of the log of a task. An empty string is returned when the log cannot be
+// read, since the log only adds detail to another error.
+func getServerTaskLog(client *client.Client, spaceID string, taskID string) string {
+	taskDetails, err := servertasks.GetDetails(client, spaceID, taskID, serverTaskLogTail)
+	if err != nil {
+		log.Printf("[WARN] unable to read the log of task (%s): %s", taskID, err)
+		return ""
+	}
+
+	return servertasks.FormatActivityLogs(taskDetails.ActivityLogs)
+}
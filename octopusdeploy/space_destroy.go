@@ -0,0 +1,151 @@
+package octopusdeploy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/feeds"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/machines"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/projects"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tasks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// maxListedSpaceContents is the number of names of each kind of resource that are listed in diagnostics.
+const maxListedSpaceContents = 10
+
+const spaceContentsPageSize = 100
+
+// spaceContents contains the names of the resources of a space that prevent it from being destroyed.
+type spaceContents struct {
+	DeploymentTargets []string
+	Feeds             []string
+	Projects          []string
+}
+
+func (c *spaceContents) isEmpty() bool {
+	return len(c.DeploymentTargets) == 0 && len(c.Feeds) == 0 && len(c.Projects) == 0
+}
+
+// isUserDefinedFeed returns false for the feeds that every space contains.
+func isUserDefinedFeed(feed feeds.IFeed) bool {
+	return feed.GetFeedType() != feeds.FeedTypeBuiltIn && feed.GetFeedType() != feeds.FeedTypeOctopusProject
+}
+
+func getSpaceContents(client *client.Client, spaceID string) (*spaceContents, error) {
+	contents := &spaceContents{}
+
+	for skip := 0; ; skip += spaceContentsPageSize {
+		page, err := projects.Get(client, spaceID, projects.ProjectsQuery{Skip: skip, Take: spaceContentsPageSize})
+		if err != nil {
+			return nil, err
+		}
+		for _, project := range page.Items {
+			contents.Projects = append(contents.Projects, project.Name)
+		}
+		if len(page.Items) == 0 || skip+len(page.Items) >= page.TotalResults {
+			break
+		}
+	}
+
+	for skip := 0; ; skip += spaceContentsPageSize {
+		page, err := machines.Get(client, spaceID, machines.MachinesQuery{Skip: skip, Take: spaceContentsPageSize})
+		if err != nil {
+			return nil, err
+		}
+		for _, deploymentTarget := range page.Items {
+			contents.DeploymentTargets = append(contents.DeploymentTargets, deploymentTarget.Name)
+		}
+		if len(page.Items) == 0 || skip+len(page.Items) >= page.TotalResults {
+			break
+		}
+	}
+
+	for skip := 0; ; skip += spaceContentsPageSize {
+		page, err := feeds.Get(client, spaceID, feeds.FeedsQuery{Skip: skip, Take: spaceContentsPageSize})
+		if err != nil {
+			return nil, err
+		}
+		for _, feed := range page.Items {
+			if isUserDefinedFeed(feed) {
+				contents.Feeds = append(contents.Feeds, feed.GetName())
+			}
+		}
+		if len(page.Items) == 0 || skip+len(page.Items) >= page.TotalResults {
+			break
+		}
+	}
+
+	return contents, nil
+}
+
+func formatSpaceContents(kind string, names []string) string {
+	listedNames := names
+	if len(listedNames) > maxListedSpaceContents {
+		listedNames = listedNames[:maxListedSpaceContents]
+	}
+
+	summary := fmt.Sprintf("  - %d %s: %s", len(names), kind, strings.Join(listedNames, ", "))
+	if len(names) > len(listedNames) {
+		summary += fmt.Sprintf(" and %d more", len(names)-len(listedNames))
+	}
+
+	return summary
+}
+
+// getSpaceNotEmptyDiagnostic describes the resources that prevent a space from being destroyed.
+func getSpaceNotEmptyDiagnostic(spaceName string, contents *spaceContents) diag.Diagnostic {
+	lines := []string{}
+	if len(contents.Projects) > 0 {
+		lines = append(lines, formatSpaceContents("project(s)", contents.Projects))
+	}
+	if len(contents.DeploymentTargets) > 0 {
+		lines = append(lines, formatSpaceContents("deployment target(s)", contents.DeploymentTargets))
+	}
+	if len(contents.Feeds) > 0 {
+		lines = append(lines, formatSpaceContents("feed(s)", contents.Feeds))
+	}
+
+	return diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("space %q is not empty", spaceName),
+		Detail: fmt.Sprintf("The space was not destroyed because prevent_destroy_if_not_empty is set and it contains:\n\n%s\n\nRemove these resources from the space, or unset prevent_destroy_if_not_empty, to destroy it.",
+			strings.Join(lines, "\n")),
+	}
+}
+
+func formatActiveTasks(activeTasks []*tasks.Task) string {
+	descriptions := []string{}
+	for _, task := range activeTasks {
+		descriptions = append(descriptions, fmt.Sprintf("%s (%s, %s)", task.Description, task.GetID(), task.State))
+	}
+
+	return strings.Join(descriptions, ", ")
+}
+
+// drainSpaceTaskQueue waits for the tasks of a space that are queued or running to complete. The task queue of the
+// space must already be stopped, so that no new tasks are started while waiting.
+func drainSpaceTaskQueue(ctx context.Context, client *client.Client, spaceID string, timeout time.Duration) error {
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		activeTasks, err := client.Tasks.Get(tasks.TasksQuery{
+			IsActive: true,
+			Spaces:   []string{spaceID},
+			Take:     maxListedSpaceContents,
+		})
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if activeTasks.TotalResults > 0 {
+			log.Printf("[INFO] waiting for %d task(s) of space (%s) to complete", activeTasks.TotalResults, spaceID)
+			return resource.RetryableError(fmt.Errorf("%d task(s) of space %s are still queued or running: %s", activeTasks.TotalResults, spaceID, formatActiveTasks(activeTasks.Items)))
+		}
+
+		return nil
+	})
+}
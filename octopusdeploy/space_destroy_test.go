@@ -0,0 +1,52 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/feeds"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tasks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSpaceNotEmptyDiagnostic(t *testing.T) {
+	deploymentTargets := []string{}
+	for i := 1; i <= 12; i++ {
+		deploymentTargets = append(deploymentTargets, fmt.Sprintf("web-%02d", i))
+	}
+
+	contents := &spaceContents{
+		DeploymentTargets: deploymentTargets,
+		Projects:          []string{"Billing", "Checkout"},
+	}
+	require.False(t, contents.isEmpty())
+
+	diagnostic := getSpaceNotEmptyDiagnostic("Sandbox", contents)
+	require.Equal(t, diag.Error, diagnostic.Severity)
+	require.Equal(t, `space "Sandbox" is not empty`, diagnostic.Summary)
+	require.Contains(t, diagnostic.Detail, "  - 2 project(s): Billing, Checkout\n")
+	require.Contains(t, diagnostic.Detail, "  - 12 deployment target(s): web-01, web-02, web-03, web-04, web-05, web-06, web-07, web-08, web-09, web-10 and 2 more\n")
+	require.NotContains(t, diagnostic.Detail, "feed(s)")
+
+	require.True(t, (&spaceContents{}).isEmpty())
+}
+
+func TestIsUserDefinedFeed(t *testing.T) {
+	builtInFeed, err := feeds.NewBuiltInFeed("Octopus Server (built-in)")
+	require.NoError(t, err)
+	require.False(t, isUserDefinedFeed(builtInFeed))
+
+	nugetFeed, err := feeds.NewNuGetFeed("NuGet", "https://api.nuget.org/v3/index.json")
+	require.NoError(t, err)
+	require.True(t, isUserDefinedFeed(nugetFeed))
+}
+
+func TestFormatActiveTasks(t *testing.T) {
+	task := tasks.NewTask()
+	task.ID = "ServerTasks-1"
+	task.Description = "Deploy Billing to Production"
+	task.State = "Executing"
+
+	require.Equal(t, "Deploy Billing to Production (ServerTasks-1, Executing)", formatActiveTasks([]*tasks.Task{task}))
+}
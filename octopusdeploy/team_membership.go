@@ -0,0 +1,183 @@
+package octopusdeploy
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/client"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/teams"
+)
+
+// teamLocks serializes the changes made to each team by this provider, so that several membership resources of the
+// same team do not overwrite each other within a single apply.
+var teamLocks sync.Map
+
+func lockTeam(teamID string) func() {
+	lock, _ := teamLocks.LoadOrStore(teamID, &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	return lock.(*sync.Mutex).Unlock
+}
+
+// getTeamMembershipFingerprint returns a value that changes whenever the members or external security groups of a team
+// change.
+func getTeamMembershipFingerprint(team *teams.Team) string {
+	members := append([]string{}, team.MemberUserIDs...)
+	sort.Strings(members)
+
+	externalSecurityGroups := []string{}
+	for _, externalSecurityGroup := range team.ExternalSecurityGroups {
+		externalSecurityGroups = append(externalSecurityGroups, fmt.Sprintf("%s=%s=%t", externalSecurityGroup.ID, externalSecurityGroup.DisplayName, externalSecurityGroup.DisplayIDAndName))
+	}
+	sort.Strings(externalSecurityGroups)
+
+	return strings.Join(members, ",") + "|" + strings.Join(externalSecurityGroups, ",")
+}
+
+// updateTeamMembership performs a read-modify-write of the membership of a team. Teams are not versioned by the
+// server, so changes made outside of this provider cannot be prevented from being overwritten. As a best effort, the
+// team is read again just before it is written, and the write is abandoned if its membership changed in the meantime.
+func updateTeamMembership(client *client.Client, teamID string, modify func(*teams.Team) error) (*teams.Team, error) {
+	unlock := lockTeam(teamID)
+	defer unlock()
+
+	team, err := client.Teams.GetByID(teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	fingerprint := getTeamMembershipFingerprint(team)
+	if err := modify(team); err != nil {
+		return nil, err
+	}
+
+	if getTeamMembershipFingerprint(team) == fingerprint {
+		return team, nil
+	}
+
+	latest, err := client.Teams.GetByID(teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	if getTeamMembershipFingerprint(latest) != fingerprint {
+		return nil, fmt.Errorf("the membership of team %s changed while it was being updated; run the operation again", teamID)
+	}
+
+	log.Printf("[INFO] updating membership of team (%s)", teamID)
+	return client.Teams.Update(team)
+}
+
+func addTeamMember(members []string, userID string) []string {
+	for _, member := range members {
+		if member == userID {
+			return members
+		}
+	}
+
+	return append(members, userID)
+}
+
+func removeTeamMember(members []string, userID string) []string {
+	remainingMembers := []string{}
+	for _, member := range members {
+		if member != userID {
+			remainingMembers = append(remainingMembers, member)
+		}
+	}
+
+	return remainingMembers
+}
+
+func findTeamExternalSecurityGroup(externalSecurityGroups []core.NamedReferenceItem, groupID string) *core.NamedReferenceItem {
+	for i := range externalSecurityGroups {
+		if externalSecurityGroups[i].ID == groupID {
+			return &externalSecurityGroups[i]
+		}
+	}
+
+	return nil
+}
+
+// setTeamExternalSecurityGroup adds an external security group to a team, or updates it if the team already has it.
+func setTeamExternalSecurityGroup(externalSecurityGroups []core.NamedReferenceItem, externalSecurityGroup core.NamedReferenceItem) []core.NamedReferenceItem {
+	if existing := findTeamExternalSecurityGroup(externalSecurityGroups, externalSecurityGroup.ID); existing != nil {
+		*existing = externalSecurityGroup
+		return externalSecurityGroups
+	}
+
+	return append(externalSecurityGroups, externalSecurityGroup)
+}
+
+func removeTeamExternalSecurityGroup(externalSecurityGroups []core.NamedReferenceItem, groupID string) []core.NamedReferenceItem {
+	remainingExternalSecurityGroups := []core.NamedReferenceItem{}
+	for _, externalSecurityGroup := range externalSecurityGroups {
+		if externalSecurityGroup.ID != groupID {
+			remainingExternalSecurityGroups = append(remainingExternalSecurityGroups, externalSecurityGroup)
+		}
+	}
+
+	return remainingExternalSecurityGroups
+}
+
+// mergeManagedTeamMembers replaces the members previously managed by a team resource with the ones it now manages,
+// leaving members that were added by other means in place.
+func mergeManagedTeamMembers(members []string, previouslyManagedMembers []string, managedMembers []string) []string {
+	mergedMembers := append([]string{}, members...)
+	for _, member := range previouslyManagedMembers {
+		mergedMembers = removeTeamMember(mergedMembers, member)
+	}
+
+	for _, member := range managedMembers {
+		mergedMembers = addTeamMember(mergedMembers, member)
+	}
+
+	return mergedMembers
+}
+
+// getManagedTeamMembers returns the members of a team that are managed by a team resource.
+func getManagedTeamMembers(members []string, managedMembers []string) []string {
+	isManaged := map[string]bool{}
+	for _, member := range managedMembers {
+		isManaged[member] = true
+	}
+
+	filteredMembers := []string{}
+	for _, member := range members {
+		if isManaged[member] {
+			filteredMembers = append(filteredMembers, member)
+		}
+	}
+
+	return filteredMembers
+}
+
+// mergeManagedTeamExternalSecurityGroups replaces the external security groups previously managed by a team resource
+// with the ones it now manages, leaving external security groups that were added by other means in place.
+func mergeManagedTeamExternalSecurityGroups(externalSecurityGroups []core.NamedReferenceItem, previouslyManagedExternalSecurityGroups []core.NamedReferenceItem, managedExternalSecurityGroups []core.NamedReferenceItem) []core.NamedReferenceItem {
+	mergedExternalSecurityGroups := append([]core.NamedReferenceItem{}, externalSecurityGroups...)
+	for _, externalSecurityGroup := range previouslyManagedExternalSecurityGroups {
+		mergedExternalSecurityGroups = removeTeamExternalSecurityGroup(mergedExternalSecurityGroups, externalSecurityGroup.ID)
+	}
+
+	for _, externalSecurityGroup := range managedExternalSecurityGroups {
+		mergedExternalSecurityGroups = setTeamExternalSecurityGroup(mergedExternalSecurityGroups, externalSecurityGroup)
+	}
+
+	return mergedExternalSecurityGroups
+}
+
+// getManagedTeamExternalSecurityGroups returns the external security groups of a team that are managed by a team resource.
+func getManagedTeamExternalSecurityGroups(externalSecurityGroups []core.NamedReferenceItem, managedExternalSecurityGroups []interface{}) []core.NamedReferenceItem {
+	filteredExternalSecurityGroups := []core.NamedReferenceItem{}
+	for _, managedExternalSecurityGroup := range expandExternalSecurityGroups(managedExternalSecurityGroups) {
+		if externalSecurityGroup := findTeamExternalSecurityGroup(externalSecurityGroups, managedExternalSecurityGroup.ID); externalSecurityGroup != nil {
+			filteredExternalSecurityGroups = append(filteredExternalSecurityGroups, *externalSecurityGroup)
+		}
+	}
+
+	return filteredExternalSecurityGroups
+}
@@ -0,0 +1,86 @@
+package octopusdeploy
+
+import (
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/core"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/teams"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddAndRemoveTeamMember(t *testing.T) {
+	members := addTeamMember([]string{"Users-1"}, "Users-2")
+	require.Equal(t, []string{"Users-1", "Users-2"}, members)
+
+	members = addTeamMember(members, "Users-1")
+	require.Equal(t, []string{"Users-1", "Users-2"}, members)
+
+	members = removeTeamMember(members, "Users-1")
+	require.Equal(t, []string{"Users-2"}, members)
+
+	members = removeTeamMember(members, "Users-3")
+	require.Equal(t, []string{"Users-2"}, members)
+}
+
+func TestSetAndRemoveTeamExternalSecurityGroup(t *testing.T) {
+	externalSecurityGroups := setTeamExternalSecurityGroup(nil, core.NamedReferenceItem{ID: "group-1", DisplayName: "Group 1"})
+	externalSecurityGroups = setTeamExternalSecurityGroup(externalSecurityGroups, core.NamedReferenceItem{ID: "group-2", DisplayName: "Group 2"})
+	require.Len(t, externalSecurityGroups, 2)
+
+	externalSecurityGroups = setTeamExternalSecurityGroup(externalSecurityGroups, core.NamedReferenceItem{ID: "group-1", DisplayName: "Renamed"})
+	require.Len(t, externalSecurityGroups, 2)
+	require.Equal(t, "Renamed", findTeamExternalSecurityGroup(externalSecurityGroups, "group-1").DisplayName)
+
+	externalSecurityGroups = removeTeamExternalSecurityGroup(externalSecurityGroups, "group-1")
+	require.Len(t, externalSecurityGroups, 1)
+	require.Nil(t, findTeamExternalSecurityGroup(externalSecurityGroups, "group-1"))
+	require.NotNil(t, findTeamExternalSecurityGroup(externalSecurityGroups, "group-2"))
+}
+
+func TestMergeManagedTeamMembers(t *testing.T) {
+	members := []string{"Users-1", "Users-2", "Users-3"}
+
+	// Users-3 was added outside of the team resource, which now manages Users-2 and Users-4 instead of Users-1 and Users-2
+	mergedMembers := mergeManagedTeamMembers(members, []string{"Users-1", "Users-2"}, []string{"Users-2", "Users-4"})
+	require.ElementsMatch(t, []string{"Users-2", "Users-3", "Users-4"}, mergedMembers)
+	require.Equal(t, []string{"Users-1", "Users-2", "Users-3"}, members)
+
+	require.Equal(t, []string{"Users-2"}, getManagedTeamMembers(mergedMembers, []string{"Users-1", "Users-2"}))
+	require.Empty(t, getManagedTeamMembers(mergedMembers, nil))
+}
+
+func TestMergeManagedTeamExternalSecurityGroups(t *testing.T) {
+	externalSecurityGroups := []core.NamedReferenceItem{{ID: "group-1"}, {ID: "group-2"}}
+
+	mergedExternalSecurityGroups := mergeManagedTeamExternalSecurityGroups(externalSecurityGroups, []core.NamedReferenceItem{{ID: "group-1"}}, []core.NamedReferenceItem{{ID: "group-3"}})
+	require.Len(t, mergedExternalSecurityGroups, 2)
+	require.Nil(t, findTeamExternalSecurityGroup(mergedExternalSecurityGroups, "group-1"))
+	require.NotNil(t, findTeamExternalSecurityGroup(mergedExternalSecurityGroups, "group-2"))
+	require.NotNil(t, findTeamExternalSecurityGroup(mergedExternalSecurityGroups, "group-3"))
+
+	managedExternalSecurityGroups := getManagedTeamExternalSecurityGroups(mergedExternalSecurityGroups, []interface{}{
+		map[string]interface{}{"id": "group-3", "display_name": "", "display_id_and_name": false},
+	})
+	require.Len(t, managedExternalSecurityGroups, 1)
+	require.Equal(t, "group-3", managedExternalSecurityGroups[0].ID)
+}
+
+func TestGetTeamMembershipFingerprint(t *testing.T) {
+	team := &teams.Team{
+		ExternalSecurityGroups: []core.NamedReferenceItem{{ID: "group-1"}},
+		MemberUserIDs:          []string{"Users-2", "Users-1"},
+	}
+	fingerprint := getTeamMembershipFingerprint(team)
+
+	reordered := &teams.Team{
+		ExternalSecurityGroups: []core.NamedReferenceItem{{ID: "group-1"}},
+		MemberUserIDs:          []string{"Users-1", "Users-2"},
+	}
+	require.Equal(t, fingerprint, getTeamMembershipFingerprint(reordered))
+
+	reordered.MemberUserIDs = addTeamMember(reordered.MemberUserIDs, "Users-3")
+	require.NotEqual(t, fingerprint, getTeamMembershipFingerprint(reordered))
+
+	team.ExternalSecurityGroups[0].DisplayName = "Group 1"
+	require.NotEqual(t, fingerprint, getTeamMembershipFingerprint(team))
+}
@@ -0,0 +1,477 @@
+package octopusdeploy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tagsets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type tenantTagCacheKey struct {
+	client  newclient.Client
+	spaceID string
+}
+
+type plannedTagKey struct {
+	client   newclient.Client
+	tagSetID string
+}
+
+// tenantTagCache holds the tag sets of each space for the lifetime of the provider, which is a single plan or apply.
+// Lookups that miss read the tag sets again, since tags can be created earlier in the same apply.
+var tenantTagCache = struct {
+	sync.Mutex
+	tagSets map[tenantTagCacheKey][]*tagsets.TagSet
+}{tagSets: map[tenantTagCacheKey][]*tagsets.TagSet{}}
+
+// plannedTenantTags holds the names of the tag sets and tags that are created or renamed by the current plan. Tag sets
+// and tags are planned before the resources that depend on them, so their tenant tags are only checked when they are
+// applied.
+var plannedTenantTags = struct {
+	sync.Mutex
+	tagSetNames map[tenantTagCacheKey]map[string]bool
+	tagNames    map[plannedTagKey]map[string]bool
+}{tagSetNames: map[tenantTagCacheKey]map[string]bool{}, tagNames: map[plannedTagKey]map[string]bool{}}
+
+// addTenantTagSchema adds the tenant_tag blocks to the schema of a resource that has tenant_tags. The blocks are an
+// alternative to canonical tag names; they are converted to tenant_tags when the plan is made.
+func addTenantTagSchema(resourceSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	resourceSchema["tenant_tag"] = getTenantTagSchema()
+	return resourceSchema
+}
+
+// customizeTenantTagsDiff converts the tenant_tag blocks of a resource to tenant_tags and validates the tenant_tags
+// that change against the tag sets of the space.
+func customizeTenantTagsDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.HasChange("tenant_tag") {
+		if !d.NewValueKnown("tenant_tag") {
+			return d.SetNewComputed("tenant_tags")
+		}
+
+		tenantTags := expandTenantTagBlocks(d.Get("tenant_tag"))
+		if !isSameStringSet(tenantTags, getSliceFromTerraformTypeList(d.Get("tenant_tags"))) {
+			if err := d.SetNew("tenant_tags", tenantTags); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !d.HasChange("tenant_tags") || !d.NewValueKnown("tenant_tags") {
+		return nil
+	}
+
+	return validateTenantTagsDiff(ctx, d, m, getSliceFromTerraformTypeList(d.Get("tenant_tags")))
+}
+
+// validateNestedTenantTagsDiff returns a function that validates the tenant_tags that are nested in an attribute (i.e.
+// the actions of the steps of a deployment process) when the attribute changes.
+func validateNestedTenantTagsDiff(key string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		if !d.HasChange(key) {
+			return nil
+		}
+
+		return validateTenantTagsDiff(ctx, d, m, collectNestedTenantTags(d.Get(key)))
+	}
+}
+
+// recordPlannedTagSetDiff records the name of a tag set that is created or renamed by the plan, so that the tenant
+// tags of its tags are not rejected before it is applied.
+func recordPlannedTagSetDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	client, ok := m.(newclient.Client)
+	if !ok || client == nil {
+		return nil
+	}
+
+	if (len(d.Id()) > 0 && !d.HasChange("name")) || !d.NewValueKnown("name") {
+		return nil
+	}
+
+	spaceID, ok := getPlannedSpaceID(d, client)
+	if !ok {
+		return nil
+	}
+
+	plannedTenantTags.Lock()
+	defer plannedTenantTags.Unlock()
+
+	key := tenantTagCacheKey{client: client, spaceID: spaceID}
+	if plannedTenantTags.tagSetNames[key] == nil {
+		plannedTenantTags.tagSetNames[key] = map[string]bool{}
+	}
+	plannedTenantTags.tagSetNames[key][d.Get("name").(string)] = true
+
+	return nil
+}
+
+// recordPlannedTagDiff records the name of a tag that is created or renamed by the plan, so that its tenant tag is not
+// rejected before it is applied. Tags of tag sets that are created by the plan are covered by the tag set.
+func recordPlannedTagDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	client, ok := m.(newclient.Client)
+	if !ok || client == nil {
+		return nil
+	}
+
+	if len(d.Id()) > 0 && !d.HasChange("name") && !d.HasChange("tag_set_id") {
+		return nil
+	}
+
+	if !d.NewValueKnown("name") || !d.NewValueKnown("tag_set_id") {
+		return nil
+	}
+
+	plannedTenantTags.Lock()
+	defer plannedTenantTags.Unlock()
+
+	key := plannedTagKey{client: client, tagSetID: d.Get("tag_set_id").(string)}
+	if plannedTenantTags.tagNames[key] == nil {
+		plannedTenantTags.tagNames[key] = map[string]bool{}
+	}
+	plannedTenantTags.tagNames[key][d.Get("name").(string)] = true
+
+	return nil
+}
+
+// getPlannedSpaceID returns the space ID of a resource that is being planned, which is the space of the provider when
+// it is not configured. It returns false when the space ID is not known until the apply.
+func getPlannedSpaceID(d *schema.ResourceDiff, client newclient.Client) (string, bool) {
+	if d.NewValueKnown("space_id") {
+		if spaceID := d.Get("space_id").(string); len(spaceID) > 0 {
+			return spaceID, true
+		}
+	} else if config := d.GetRawConfig(); !config.IsNull() && config.Type().IsObjectType() && !config.GetAttr("space_id").IsNull() {
+		return "", false
+	}
+
+	return client.GetSpaceID(), true
+}
+
+// validateTenantTagsDiff validates tenant tags against the tag sets of the space when the plan is made. Tags that
+// cannot be checked yet (i.e. tags whose tag set or tag is created by the same plan) are skipped; they are checked
+// by validateResourceTenantTags when they are applied.
+func validateTenantTagsDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}, tenantTags []string) error {
+	client, ok := m.(newclient.Client)
+	if !ok || client == nil {
+		return nil
+	}
+
+	spaceID, ok := getPlannedSpaceID(d, client)
+	if !ok {
+		return nil
+	}
+
+	knownTenantTags := getKnownTenantTags(tenantTags)
+	if len(knownTenantTags) == 0 {
+		return nil
+	}
+
+	plannedTagSetNames, plannedTagNames := getPlannedTenantTags(client, spaceID)
+
+	tagSets, err := getTagSets(ctx, client, spaceID, false)
+	if err != nil {
+		return err
+	}
+
+	if err := checkPlannedTenantTags(knownTenantTags, tagSets, plannedTagSetNames, plannedTagNames); err == nil {
+		return nil
+	}
+
+	log.Printf("[INFO] reading the tag sets of space (%s) again to validate tenant tags", spaceID)
+
+	tagSets, err = getTagSets(ctx, client, spaceID, true)
+	if err != nil {
+		return err
+	}
+
+	return checkPlannedTenantTags(knownTenantTags, tagSets, plannedTagSetNames, plannedTagNames)
+}
+
+// validateResourceTenantTags validates the tenant_tags of a resource against the tag sets of its space when they
+// change. It is called when the resource is created or updated, as a fallback for the tags that could not be checked
+// when the plan was made; the others are found in the tag sets that are read once per apply.
+func validateResourceTenantTags(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	if !d.HasChange("tenant_tags") {
+		return nil
+	}
+
+	return validateTenantTags(ctx, m, d.Get("space_id").(string), getSliceFromTerraformTypeList(d.Get("tenant_tags")))
+}
+
+// validateNestedResourceTenantTags validates the tenant_tags that are nested in an attribute (i.e. the actions of the
+// steps of a deployment process) when the attribute changes, in the same way as validateResourceTenantTags.
+func validateNestedResourceTenantTags(ctx context.Context, d *schema.ResourceData, m interface{}, key string) error {
+	if !d.HasChange(key) {
+		return nil
+	}
+
+	return validateTenantTags(ctx, m, d.Get("space_id").(string), collectNestedTenantTags(d.Get(key)))
+}
+
+func validateTenantTags(ctx context.Context, m interface{}, spaceID string, tenantTags []string) error {
+	client, ok := m.(newclient.Client)
+	if !ok || client == nil {
+		return nil
+	}
+
+	if len(spaceID) == 0 {
+		spaceID = client.GetSpaceID()
+	}
+
+	knownTenantTags := getKnownTenantTags(tenantTags)
+	if len(knownTenantTags) == 0 {
+		return nil
+	}
+
+	tagSets, err := getTagSets(ctx, client, spaceID, false)
+	if err != nil {
+		return err
+	}
+
+	if err := checkTenantTags(knownTenantTags, getCanonicalTagNames(tagSets)); err == nil {
+		return nil
+	}
+
+	log.Printf("[INFO] reading the tag sets of space (%s) again to validate tenant tags", spaceID)
+
+	tagSets, err = getTagSets(ctx, client, spaceID, true)
+	if err != nil {
+		return err
+	}
+
+	return checkTenantTags(knownTenantTags, getCanonicalTagNames(tagSets))
+}
+
+// checkPlannedTenantTags checks the tenant tags whose tag set and tag are not created or renamed by the plan.
+func checkPlannedTenantTags(tenantTags []string, tagSets []*tagsets.TagSet, plannedTagSetNames map[string]bool, plannedTagNames map[string]map[string]bool) error {
+	checkedTenantTags := []string{}
+	for _, tenantTag := range tenantTags {
+		if tagSetName, tagName, ok := strings.Cut(tenantTag, "/"); ok {
+			tagSet := findTagSetByName(tagSets, tagSetName)
+			if tagSet == nil && plannedTagSetNames[tagSetName] {
+				continue
+			}
+
+			if tagSet != nil && plannedTagNames[tagSet.GetID()][tagName] {
+				continue
+			}
+		}
+
+		checkedTenantTags = append(checkedTenantTags, tenantTag)
+	}
+
+	return checkTenantTags(checkedTenantTags, getCanonicalTagNames(tagSets))
+}
+
+// checkTenantTags returns an error for each tenant tag that is not one of the canonical tag names, along with the
+// closest canonical tag name.
+func checkTenantTags(tenantTags []string, canonicalTagNames []string) error {
+	existingTags := map[string]bool{}
+	candidates := []map[string]interface{}{}
+	for _, canonicalTagName := range canonicalTagNames {
+		existingTags[canonicalTagName] = true
+		candidates = append(candidates, map[string]interface{}{"name": canonicalTagName})
+	}
+
+	errs := []error{}
+	for _, tenantTag := range tenantTags {
+		if existingTags[tenantTag] {
+			continue
+		}
+
+		message := fmt.Sprintf("tenant tag %q does not exist", tenantTag)
+		if !strings.Contains(tenantTag, "/") {
+			message = fmt.Sprintf("tenant tag %q is not a canonical tag name (i.e. `Region/EU`)", tenantTag)
+		}
+
+		if suggestions := getLookupSuggestions(tenantTag, candidates); len(suggestions) > 0 {
+			message += fmt.Sprintf("; did you mean %s?", suggestions[0])
+		}
+
+		errs = append(errs, errors.New(message))
+	}
+
+	return errors.Join(errs...)
+}
+
+// collectNestedTenantTags returns the values of every tenant_tags attribute that is nested in the input value.
+func collectNestedTenantTags(v interface{}) []string {
+	tenantTags := []string{}
+	switch value := v.(type) {
+	case []interface{}:
+		for _, element := range value {
+			tenantTags = append(tenantTags, collectNestedTenantTags(element)...)
+		}
+	case *schema.Set:
+		tenantTags = append(tenantTags, collectNestedTenantTags(value.List())...)
+	case map[string]interface{}:
+		for key, nestedValue := range value {
+			if key == "tenant_tags" {
+				tenantTags = append(tenantTags, getSliceFromTerraformTypeList(nestedValue)...)
+			} else {
+				tenantTags = append(tenantTags, collectNestedTenantTags(nestedValue)...)
+			}
+		}
+	}
+
+	return tenantTags
+}
+
+// expandTenantTagBlocks returns the sorted canonical names of tenant_tag blocks.
+func expandTenantTagBlocks(v interface{}) []string {
+	tenantTagBlocks, ok := v.(*schema.Set)
+	if !ok {
+		return []string{}
+	}
+
+	tenantTags := []string{}
+	for _, tenantTagBlock := range tenantTagBlocks.List() {
+		flattenedMap, ok := tenantTagBlock.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		tenantTags = append(tenantTags, fmt.Sprintf("%s/%s", flattenedMap["tag_set"], flattenedMap["tag"]))
+	}
+
+	sort.Strings(tenantTags)
+	return tenantTags
+}
+
+func findTagSetByName(tagSets []*tagsets.TagSet, name string) *tagsets.TagSet {
+	for _, tagSet := range tagSets {
+		if tagSet.Name == name {
+			return tagSet
+		}
+	}
+
+	return nil
+}
+
+func getCanonicalTagNames(tagSets []*tagsets.TagSet) []string {
+	canonicalTagNames := []string{}
+	for _, tagSet := range tagSets {
+		for _, tag := range tagSet.Tags {
+			if len(tag.CanonicalTagName) > 0 {
+				canonicalTagNames = append(canonicalTagNames, tag.CanonicalTagName)
+			} else {
+				canonicalTagNames = append(canonicalTagNames, fmt.Sprintf("%s/%s", tagSet.Name, tag.Name))
+			}
+		}
+	}
+
+	return canonicalTagNames
+}
+
+// getKnownTenantTags returns the tenant tags whose values are known; values that are unknown until the apply are
+// empty.
+func getKnownTenantTags(tenantTags []string) []string {
+	knownTenantTags := []string{}
+	for _, tenantTag := range tenantTags {
+		if len(tenantTag) > 0 {
+			knownTenantTags = append(knownTenantTags, tenantTag)
+		}
+	}
+
+	return knownTenantTags
+}
+
+// getPlannedTenantTags returns the names of the tag sets of a space that are created or renamed by the plan, and the
+// names of the tags of each tag set that are created or renamed by the plan.
+func getPlannedTenantTags(client newclient.Client, spaceID string) (map[string]bool, map[string]map[string]bool) {
+	plannedTenantTags.Lock()
+	defer plannedTenantTags.Unlock()
+
+	tagSetNames := map[string]bool{}
+	for name := range plannedTenantTags.tagSetNames[tenantTagCacheKey{client: client, spaceID: spaceID}] {
+		tagSetNames[name] = true
+	}
+
+	tagNames := map[string]map[string]bool{}
+	for key, names := range plannedTenantTags.tagNames {
+		if key.client != client {
+			continue
+		}
+
+		tagNames[key.tagSetID] = map[string]bool{}
+		for name := range names {
+			tagNames[key.tagSetID][name] = true
+		}
+	}
+
+	return tagSetNames, tagNames
+}
+
+func getTagSets(ctx context.Context, client newclient.Client, spaceID string, refresh bool) ([]*tagsets.TagSet, error) {
+	tenantTagCache.Lock()
+	defer tenantTagCache.Unlock()
+
+	key := tenantTagCacheKey{client: client, spaceID: spaceID}
+	if tagSets, ok := tenantTagCache.tagSets[key]; ok && !refresh {
+		return tagSets, nil
+	}
+
+	existingTagSets, err := tagsets.Get(client, spaceID, tagsets.TagSetsQuery{})
+	if err != nil {
+		return nil, err
+	}
+
+	nextTagSets, err := getNextPages[*tagsets.TagSet](ctx, client, existingTagSets.PagedResults)
+	if err != nil {
+		return nil, err
+	}
+
+	tagSets := append(existingTagSets.Items, nextTagSets...)
+	tenantTagCache.tagSets[key] = tagSets
+	return tagSets, nil
+}
+
+func getTenantTagSchema() *schema.Schema {
+	return &schema.Schema{
+		ConflictsWith: []string{"tenant_tags"},
+		Description:   "A tenant tag associated with this resource, as an alternative to its canonical name in `tenant_tags` (i.e. `tag_set = \"Region\"` and `tag = \"EU\"` for `Region/EU`).",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tag": {
+					Description:      "The name of the tag.",
+					Required:         true,
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+				},
+				"tag_set": {
+					Description:      "The name of the tag set of the tag.",
+					Required:         true,
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+				},
+			},
+		},
+		Optional: true,
+		Type:     schema.TypeSet,
+	}
+}
+
+func isSameStringSet(a []string, b []string) bool {
+	values := map[string]int{}
+	for _, value := range a {
+		values[value]++
+	}
+	for _, value := range b {
+		values[value]--
+	}
+
+	for _, count := range values {
+		if count != 0 {
+			return false
+		}
+	}
+
+	return true
+}
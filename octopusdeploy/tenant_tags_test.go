@@ -0,0 +1,177 @@
+package octopusdeploy
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/newclient"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/resources"
+	"github.com/OctopusDeploy/go-octopusdeploy/v2/pkg/tagsets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckTenantTags(t *testing.T) {
+	canonicalTagNames := []string{"Region/EU", "Region/US", "Tier/Gold"}
+
+	require.NoError(t, checkTenantTags([]string{"Region/EU", "Tier/Gold"}, canonicalTagNames))
+
+	err := checkTenantTags([]string{"Region/EU", "Regoin/EU", "Tier/Platinum"}, canonicalTagNames)
+	require.EqualError(t, err, "tenant tag \"Regoin/EU\" does not exist; did you mean \"Region/EU\"?\ntenant tag \"Tier/Platinum\" does not exist")
+
+	err = checkTenantTags([]string{"Gold"}, canonicalTagNames)
+	require.EqualError(t, err, "tenant tag \"Gold\" is not a canonical tag name (i.e. `Region/EU`); did you mean \"Tier/Gold\"?")
+}
+
+func TestCollectNestedTenantTags(t *testing.T) {
+	steps := []interface{}{
+		map[string]interface{}{
+			"name": "Deploy",
+			"action": []interface{}{
+				map[string]interface{}{"name": "Web", "tenant_tags": []interface{}{"Region/EU"}},
+				map[string]interface{}{"name": "Database", "tenant_tags": []interface{}{}},
+			},
+			"run_script_action": []interface{}{
+				map[string]interface{}{"name": "Notify", "tenant_tags": []interface{}{"Tier/Gold", "Region/US"}},
+			},
+		},
+	}
+
+	require.ElementsMatch(t, []string{"Region/EU", "Region/US", "Tier/Gold"}, collectNestedTenantTags(steps))
+	require.Empty(t, collectNestedTenantTags(nil))
+}
+
+func TestTenantTagBlocksDiff(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "Tenants-1",
+		Attributes: map[string]string{
+			"id":            "Tenants-1",
+			"name":          "Acme",
+			"space_id":      "Spaces-1",
+			"tenant_tags.#": "1",
+			"tenant_tags.0": "Region/EU",
+		},
+	}
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "Acme",
+		"tenant_tag": []interface{}{
+			map[string]interface{}{"tag_set": "Tier", "tag": "Gold"},
+			map[string]interface{}{"tag_set": "Region", "tag": "EU"},
+		},
+	})
+
+	diff, err := resourceTenant().Diff(context.Background(), state, config, nil)
+	require.NoError(t, err)
+	require.Equal(t, "2", diff.Attributes["tenant_tags.#"].New)
+	require.NotContains(t, diff.Attributes, "tenant_tags.0")
+	require.Equal(t, "Tier/Gold", diff.Attributes["tenant_tags.1"].New)
+
+	// tags that are already applied, in any order, leave tenant_tags as is
+	state.Attributes["tenant_tags.#"] = "2"
+	state.Attributes["tenant_tags.0"] = "Tier/Gold"
+	state.Attributes["tenant_tags.1"] = "Region/EU"
+
+	diff, err = resourceTenant().Diff(context.Background(), state, config, nil)
+	require.NoError(t, err)
+	require.NotContains(t, diff.Attributes, "tenant_tags.#")
+	require.NotContains(t, diff.Attributes, "tenant_tags.0")
+}
+
+func TestIsSameStringSet(t *testing.T) {
+	require.True(t, isSameStringSet([]string{"a", "b"}, []string{"b", "a"}))
+	require.True(t, isSameStringSet(nil, []string{}))
+	require.False(t, isSameStringSet([]string{"a", "a"}, []string{"a"}))
+	require.False(t, isSameStringSet([]string{"a"}, []string{"b"}))
+}
+
+// newTagSetsServer serves the tag sets of Spaces-1, which can be modified by the test to simulate tags that are created
+// during an apply.
+func newTagSetsServer(t *testing.T) (newclient.Client, *[]*tagsets.TagSet, *int) {
+	tagSets := []*tagsets.TagSet{}
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/Spaces-1/tagsets", r.URL.Path)
+		requests++
+
+		page := resources.Resources[*tagsets.TagSet]{Items: tagSets}
+		page.ItemsPerPage = len(tagSets)
+		page.TotalResults = len(tagSets)
+
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(page))
+	}))
+	t.Cleanup(server.Close)
+
+	baseURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	client := newclient.NewClientS(&newclient.HttpSession{
+		BaseURL:    baseURL,
+		HttpClient: server.Client(),
+	}, "Spaces-1")
+
+	return client, &tagSets, &requests
+}
+
+func TestTenantTagsCreatedInSameConfiguration(t *testing.T) {
+	client, tagSets, _ := newTagSetsServer(t)
+
+	tagSet := tagsets.NewTagSet("tag1")
+	tagSet.ID = "TagSets-1"
+	tagSet.Tags = []*tagsets.Tag{{CanonicalTagName: "tag1/a", Name: "a"}}
+	*tagSets = append(*tagSets, tagSet)
+
+	config := map[string]interface{}{
+		"name":        "Acme",
+		"space_id":    "Spaces-1",
+		"tenant_tags": []interface{}{"tag1/a", "tag1/b"},
+	}
+
+	// tags that are not created by the plan are rejected when the plan is made
+	_, err := resourceTenant().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), client)
+	require.EqualError(t, err, "tenant tag \"tag1/b\" does not exist; did you mean \"tag1/a\"?")
+
+	_, err = resourceTenant().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":        "Acme",
+		"space_id":    "Spaces-1",
+		"tenant_tags": []interface{}{"tag2/c"},
+	}), client)
+	require.EqualError(t, err, "tenant tag \"tag2/c\" does not exist; did you mean \"tag1/a\"?")
+
+	// tags and tag sets that are planned before the tenant are skipped until they are applied
+	_, err = resourceTag().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"color":      "#333333",
+		"name":       "b",
+		"tag_set_id": "TagSets-1",
+	}), client)
+	require.NoError(t, err)
+
+	_, err = resourceTagSet().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "tag2",
+	}), client)
+	require.NoError(t, err)
+
+	diff, err := resourceTenant().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), client)
+	require.NoError(t, err)
+	require.NotNil(t, diff)
+
+	_, err = resourceTenant().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":        "Acme",
+		"space_id":    "Spaces-1",
+		"tenant_tags": []interface{}{"tag1/a", "tag2/c", "tag1/d"},
+	}), client)
+	require.EqualError(t, err, "tenant tag \"tag1/d\" does not exist; did you mean \"tag1/a\"?")
+
+	// the skipped tags are checked when they are applied, after the tags that they depend on are created
+	d := schema.TestResourceDataRaw(t, getTenantResourceSchema(), config)
+	require.Error(t, validateResourceTenantTags(context.Background(), d, client))
+
+	tagSet.Tags = append(tagSet.Tags, &tagsets.Tag{CanonicalTagName: "tag1/b", Name: "b"})
+	require.NoError(t, validateResourceTenantTags(context.Background(), d, client))
+}